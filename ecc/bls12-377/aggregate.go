@@ -0,0 +1,71 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"errors"
+)
+
+// SignatureDST is the domain separation tag with which AggregateVerify hashes
+// the messages to G1. It follows the naming of the BLS signature ciphersuites
+// of draft-irtf-cfrg-bls-signature (basic scheme, signatures in G1).
+const SignatureDST = "BLS_SIG_BLS12377G1_XMD:SHA-256_SSWU_RO_NUL_"
+
+var (
+	ErrAggregateSize     = errors.New("number of public keys and messages differ")
+	ErrAggregateEmpty    = errors.New("no message to verify")
+	ErrDuplicateMessages = errors.New("aggregated messages must be distinct")
+)
+
+// AggregateVerify verifies a BLS aggregate signature aggSig = ∑ᵢ skᵢ⋅H(msgs[i]),
+// signatures being in G1 and public keys pubKeys[i] = skᵢ⋅g₂ in G2, that is
+//
+//	e(aggSig, g₂) = ∏ᵢ e(H(msgs[i]), pubKeys[i])
+//
+// checked with a single multi-pairing. The messages are hashed to G1 with
+// HashToG1 and SignatureDST.
+//
+// The messages must be distinct, otherwise the scheme is subject to rogue key
+// attacks: an error is returned in that case, as well as when the number of
+// public keys and messages differ. Public keys and signature which are not in
+// the prime order subgroups, or are the point at infinity, fail the verification.
+func AggregateVerify(pubKeys []G2Affine, msgs [][]byte, aggSig G1Affine) (bool, error) {
+	if len(pubKeys) != len(msgs) {
+		return false, ErrAggregateSize
+	}
+	if len(msgs) == 0 {
+		return false, ErrAggregateEmpty
+	}
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false, ErrDuplicateMessages
+		}
+		seen[string(msg)] = struct{}{}
+	}
+
+	if aggSig.IsInfinity() || !aggSig.IsInSubGroup() {
+		return false, nil
+	}
+	for i := range pubKeys {
+		if pubKeys[i].IsInfinity() || !pubKeys[i].IsInSubGroup() {
+			return false, nil
+		}
+	}
+
+	// ∏ᵢ e(H(msgs[i]), pubKeys[i]) ⋅ e(aggSig, -g₂) =? 1
+	P := make([]G1Affine, len(msgs)+1)
+	Q := make([]G2Affine, len(msgs)+1)
+	for i, msg := range msgs {
+		var err error
+		if P[i], err = HashToG1(msg, []byte(SignatureDST)); err != nil {
+			return false, err
+		}
+		Q[i] = pubKeys[i]
+	}
+	P[len(msgs)] = aggSig
+	Q[len(msgs)] = PrecomputeNegated(g2GenAff)
+
+	return PairingCheck(P, Q)
+}
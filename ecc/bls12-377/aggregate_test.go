@@ -0,0 +1,71 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateVerify(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 5
+	pubKeys := make([]G2Affine, n)
+	msgs := make([][]byte, n)
+	var aggSig G1Jac
+	for i := 0; i < n; i++ {
+		var sk fr.Element
+		sk.MustSetRandom()
+		var s big.Int
+		sk.BigInt(&s)
+
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+		h, err := HashToG1(msgs[i], []byte(SignatureDST))
+		assert.NoError(err)
+		var sig G1Affine
+		sig.ScalarMultiplication(&h, &s)
+		aggSig.AddMixed(&sig)
+		pubKeys[i].ScalarMultiplication(&g2GenAff, &s)
+	}
+	var sig G1Affine
+	sig.FromJacobian(&aggSig)
+
+	ok, err := AggregateVerify(pubKeys, msgs, sig)
+	assert.NoError(err)
+	assert.True(ok, "valid aggregate signature rejected")
+
+	// altered message
+	altered := append([][]byte(nil), msgs...)
+	altered[2] = []byte("another message")
+	ok, err = AggregateVerify(pubKeys, altered, sig)
+	assert.NoError(err)
+	assert.False(ok, "aggregate signature accepted for an altered message")
+
+	// swapped public keys
+	swapped := append([]G2Affine(nil), pubKeys...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	ok, err = AggregateVerify(swapped, msgs, sig)
+	assert.NoError(err)
+	assert.False(ok, "aggregate signature accepted for swapped public keys")
+
+	// infinity signature
+	ok, err = AggregateVerify(pubKeys, msgs, G1Affine{})
+	assert.NoError(err)
+	assert.False(ok)
+
+	// invalid inputs
+	_, err = AggregateVerify(pubKeys[1:], msgs, sig)
+	assert.ErrorIs(err, ErrAggregateSize)
+	_, err = AggregateVerify(nil, nil, sig)
+	assert.ErrorIs(err, ErrAggregateEmpty)
+	duplicated := append([][]byte(nil), msgs...)
+	duplicated[4] = msgs[1]
+	_, err = AggregateVerify(pubKeys, duplicated, sig)
+	assert.ErrorIs(err, ErrDuplicateMessages)
+}
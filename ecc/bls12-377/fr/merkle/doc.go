@@ -0,0 +1,13 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package merkle implements a sparse Merkle tree over fr.Element.
+//
+// The tree has a fixed depth chosen at construction. Every leaf is initially
+// zero, and internal nodes are computed with the two-to-one Poseidon2
+// compression function of package poseidon2. Hashes of empty subtrees are
+// precomputed, so that only the non-empty nodes are stored and a tree of
+// depth up to 64 can be used with arbitrary uint64 indices.
+package merkle
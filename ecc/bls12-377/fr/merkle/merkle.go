@@ -0,0 +1,143 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package merkle
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/poseidon2"
+)
+
+var (
+	ErrInvalidDepth    = errors.New("merkle: depth must be between 1 and 64")
+	ErrIndexOutOfRange = errors.New("merkle: leaf index out of range")
+)
+
+// MaxDepth is the maximum depth of a Tree.
+const MaxDepth = 64
+
+// defaultPermutation is the width 2 Poseidon2 permutation used for compression.
+var defaultPermutation = sync.OnceValue(poseidon2.NewDefaultPermutation)
+
+// Tree is a sparse Merkle tree of fixed depth. Leaves are indexed from 0 to
+// 2^depth - 1 and default to zero.
+//
+// A Tree is not safe for concurrent use.
+type Tree struct {
+	depth int
+
+	// nodes[0] stores the non-empty leaves and nodes[depth] the root, if the
+	// tree is not empty.
+	nodes []map[uint64]fr.Element
+
+	// empty[i] is the hash of an empty subtree of height i.
+	empty []fr.Element
+}
+
+// NewTree returns an empty Merkle tree of the given depth.
+func NewTree(depth int) (*Tree, error) {
+	if depth < 1 || depth > MaxDepth {
+		return nil, ErrInvalidDepth
+	}
+	t := &Tree{
+		depth: depth,
+		nodes: make([]map[uint64]fr.Element, depth+1),
+		empty: make([]fr.Element, depth+1),
+	}
+	for i := range t.nodes {
+		t.nodes[i] = make(map[uint64]fr.Element)
+	}
+	for i := 1; i <= depth; i++ {
+		t.empty[i] = compress(&t.empty[i-1], &t.empty[i-1])
+	}
+	return t, nil
+}
+
+// Depth returns the depth of the tree, that is the length of its proofs.
+func (t *Tree) Depth() int {
+	return t.depth
+}
+
+// Insert sets the leaf at position index and updates the path to the root.
+// It panics if index is not smaller than 2^depth.
+func (t *Tree) Insert(index uint64, leaf fr.Element) {
+	if !t.inRange(index) {
+		panic(ErrIndexOutOfRange)
+	}
+	t.nodes[0][index] = leaf
+	for i := 0; i < t.depth; i++ {
+		left, right := t.node(i, index&^1), t.node(i, index|1)
+		index >>= 1
+		t.nodes[i+1][index] = compress(&left, &right)
+	}
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() fr.Element {
+	return t.node(t.depth, 0)
+}
+
+// Proof returns the authentication path of the leaf at position index. The
+// path lists the sibling nodes from the leaf level up to the level just
+// below the root.
+func (t *Tree) Proof(index uint64) ([]fr.Element, error) {
+	if !t.inRange(index) {
+		return nil, ErrIndexOutOfRange
+	}
+	path := make([]fr.Element, t.depth)
+	for i := 0; i < t.depth; i++ {
+		path[i] = t.node(i, index^1)
+		index >>= 1
+	}
+	return path, nil
+}
+
+// VerifyProof returns true if path is a valid authentication path for leaf
+// at position index in a tree of depth len(path) with the given root.
+func VerifyProof(root, leaf fr.Element, index uint64, path []fr.Element) bool {
+	if len(path) < 1 || len(path) > MaxDepth {
+		return false
+	}
+	if len(path) < MaxDepth && index>>len(path) != 0 {
+		return false
+	}
+	cur := leaf
+	for i := range path {
+		if index&1 == 0 {
+			cur = compress(&cur, &path[i])
+		} else {
+			cur = compress(&path[i], &cur)
+		}
+		index >>= 1
+	}
+	return cur.Equal(&root)
+}
+
+// node returns the node at the given level and position, falling back to the
+// hash of an empty subtree.
+func (t *Tree) node(level int, index uint64) fr.Element {
+	if n, ok := t.nodes[level][index]; ok {
+		return n
+	}
+	return t.empty[level]
+}
+
+func (t *Tree) inRange(index uint64) bool {
+	return t.depth == MaxDepth || index>>t.depth == 0
+}
+
+// compress returns the two-to-one Poseidon2 compression of left and right.
+// It matches poseidon2.Permutation.Compress without the byte conversions.
+func compress(left, right *fr.Element) fr.Element {
+	x := [2]fr.Element{*left, *right}
+	if err := defaultPermutation().Permutation(x[:]); err != nil {
+		panic(err)
+	}
+	x[1].Add(&x[1], right)
+	return x[1]
+}
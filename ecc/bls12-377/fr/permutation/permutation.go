@@ -262,6 +262,12 @@ func Prove(pk kzg.ProvingKey, t1, t2 []fr.Element) (Proof, error) {
 
 }
 
+// Commitments returns the commitments to t1 and t2, so that the proof can be
+// bound to commitments computed elsewhere.
+func (proof *Proof) Commitments() (t1, t2 kzg.Digest) {
+	return proof.t1, proof.t2
+}
+
 // Verify verifies a permutation proof.
 func Verify(vk kzg.VerifyingKey, proof Proof) error {
 
@@ -92,7 +92,52 @@ func TestLookupTable(t *testing.T) {
 		}
 	}
 
-	// wrong proof
+	// wrong proof: each entry of the tuple is in its column, but the tuple is not in the table
+	{
+		fTable[0][0].Set(&lookupTable[0][0])
+		fTable[1][0].Set(&lookupTable[1][1])
+		fTable[2][0].Set(&lookupTable[2][0])
+		proof, err := ProveLookupTables(kzgSrs.Pk, fTable, lookupTable)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = VerifyLookupTables(kzgSrs.Vk, proof)
+		if err == nil {
+			t.Fatal("a tuple not in the table should be rejected")
+		}
+	}
+
+	// wrong proof: the proof is computed against another table than the committed one
+	{
+		otherTable := make([]fr.Vector, 3)
+		for i := 0; i < 3; i++ {
+			otherTable[i] = make(fr.Vector, 8)
+			copy(otherTable[i], lookupTable[i])
+			otherTable[i][7].Set(&fTable[i][0])
+		}
+		proof, err := ProveLookupTables(kzgSrs.Pk, fTable, otherTable)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = VerifyLookupTables(kzgSrs.Vk, proof)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// replace the commitments of the table by the ones of lookupTable
+		honestProof, err := ProveLookupTables(kzgSrs.Pk, fTable, lookupTable)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof.ts = honestProof.ts
+		err = VerifyLookupTables(kzgSrs.Vk, proof)
+		if err == nil {
+			t.Fatal("the proof should be bound to the commitments of the table")
+		}
+	}
+
+	// wrong proof: random entry
 	{
 		fTable[0][0].MustSetRandom()
 		proof, err := ProveLookupTables(kzgSrs.Pk, fTable, lookupTable)
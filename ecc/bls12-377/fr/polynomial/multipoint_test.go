@@ -0,0 +1,150 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func randomPolynomial(n int) Polynomial {
+	p := make(Polynomial, n)
+	fr.Vector(p).MustSetRandom()
+	return p
+}
+
+func TestPolynomialEvaluateMany(t *testing.T) {
+	assert := require.New(t)
+
+	// the subproduct tree is checked below multipointThreshold too, with more
+	// coefficients than points and conversely
+	for _, sizes := range [][2]int{{0, 5}, {5, 0}, {1, 200}, {20, 300}, {130, 130}, {513, 300}, {300, 1000}, {1000, 129}, {5000, 35}} {
+		p := randomPolynomial(sizes[0])
+		points := make([]fr.Element, sizes[1])
+		fr.Vector(points).MustSetRandom()
+		if len(points) > 10 {
+			// repeated points
+			points[3] = points[7]
+		}
+
+		evals := p.EvaluateMany(points)
+		assert.Equal(len(points), len(evals))
+		treeEvals := make([]fr.Element, len(points))
+		if len(p) != 0 {
+			newSubproductTree(points).evaluate(p, treeEvals)
+		}
+		for i := range points {
+			var expected fr.Element
+			if len(p) != 0 {
+				expected = p.Eval(&points[i])
+			}
+			assert.True(evals[i].Equal(&expected), "sizes %v: wrong evaluation at point %d", sizes, i)
+			assert.True(treeEvals[i].Equal(&expected), "sizes %v: wrong subproduct tree evaluation at point %d", sizes, i)
+		}
+	}
+
+	if testing.Short() {
+		return
+	}
+	p := randomPolynomial(multipointThreshold + 1)
+	points := make([]fr.Element, multipointThreshold)
+	fr.Vector(points).MustSetRandom()
+	evals := p.EvaluateMany(points)
+	for i := range points {
+		expected := p.Eval(&points[i])
+		assert.True(evals[i].Equal(&expected), "wrong evaluation at point %d", i)
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{1, 2, 17, 100, 300} {
+		x := make([]fr.Element, n)
+		y := make([]fr.Element, n)
+		fr.Vector(x).MustSetRandom()
+		fr.Vector(y).MustSetRandom()
+
+		f, err := Interpolate(x, y)
+		assert.NoError(err)
+		assert.Equal(n, len(f))
+		for i := range x {
+			eval := f.Eval(&x[i])
+			assert.True(eval.Equal(&y[i]), "n=%d: wrong evaluation at x[%d]", n, i)
+		}
+	}
+
+	// a low degree polynomial is reconstructed exactly
+	p := randomPolynomial(5)
+	x := make([]fr.Element, 40)
+	fr.Vector(x).MustSetRandom()
+	f, err := Interpolate(x, p.EvaluateMany(x))
+	assert.NoError(err)
+	low := f[:len(p)]
+	assert.True(low.Equal(p))
+	for i := len(p); i < len(f); i++ {
+		assert.True(f[i].IsZero())
+	}
+
+	// invalid inputs
+	_, err = Interpolate(x, make([]fr.Element, len(x)-1))
+	assert.ErrorIs(err, ErrInterpolationSize)
+	x[12] = x[30]
+	_, err = Interpolate(x, make([]fr.Element, len(x)))
+	assert.ErrorIs(err, ErrDuplicateInterpolationPoint)
+
+	f, err = Interpolate(nil, nil)
+	assert.NoError(err)
+	assert.Equal(0, len(f))
+}
+
+func TestKaratsubaMul(t *testing.T) {
+	assert := require.New(t)
+
+	for _, sizes := range [][2]int{{1, 1}, {31, 40}, {32, 32}, {100, 33}, {257, 64}, {200, 199}, {1000, 40}} {
+		a, b := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+		res := karatsubaMul(a, b)
+		assert.True(res.Equal(mul(a, b)), "sizes %v", sizes)
+	}
+}
+
+func TestRemMonic(t *testing.T) {
+	assert := require.New(t)
+
+	for _, sizes := range [][2]int{{100, 10}, {200, 65}, {513, 257}, {64, 64}, {1000, 40}, {1000, 333}} {
+		f, g := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+		g[len(g)-1].SetOne()
+		_, expected, err := f.DivRem(g)
+		assert.NoError(err)
+		r := remMonic(f, g)
+		assert.True(r.Equal(expected), "sizes %v", sizes)
+	}
+}
+
+func BenchmarkEvaluateMany(b *testing.B) {
+	for _, size := range []int{1 << 5, 1 << 7, 1 << 9, 1 << 11, 1 << 12, 1 << 13} {
+		p := randomPolynomial(size)
+		points := make([]fr.Element, size)
+		fr.Vector(points).MustSetRandom()
+		res := make([]fr.Element, size)
+
+		b.Run(fmt.Sprintf("horner/size=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range points {
+					res[j] = p.Eval(&points[j])
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("subproduct-tree/size=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				newSubproductTree(points).evaluate(p, res)
+			}
+		})
+	}
+}
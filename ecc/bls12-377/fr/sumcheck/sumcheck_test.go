@@ -0,0 +1,110 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package sumcheck
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/stretchr/testify/require"
+)
+
+func newTranscript(nbVars int) *fiatshamir.Transcript {
+	return fiatshamir.NewTranscript(sha256.New(), ChallengeNames(nbVars)...)
+}
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	p := make(polynomial.MultiLin, 1<<nbVars)
+	fr.Vector(p).MustSetRandom()
+	return p
+}
+
+func TestSumcheck(t *testing.T) {
+	for nbVars := 1; nbVars <= 8; nbVars++ {
+		assert := require.New(t)
+
+		poly := randomMultiLin(nbVars)
+		backup := poly.Clone()
+		sum := poly.Sum()
+
+		proof, err := Prove(poly, sum, newTranscript(nbVars))
+		assert.NoError(err)
+		assert.Equal(backup, poly, "Prove must not modify the polynomial")
+
+		challenges, err := Verify(sum, nbVars, proof, newTranscript(nbVars))
+		assert.NoError(err)
+
+		// the sum claim is reduced to an evaluation claim
+		eval := poly.Evaluate(challenges, nil)
+		assert.True(eval.Equal(&proof.FinalEvaluation), "final evaluation mismatch")
+	}
+}
+
+func TestSumcheckTampered(t *testing.T) {
+	assert := require.New(t)
+	const nbVars = 5
+
+	poly := randomMultiLin(nbVars)
+	sum := poly.Sum()
+
+	proof, err := Prove(poly, sum, newTranscript(nbVars))
+	assert.NoError(err)
+
+	var one, wrongSum fr.Element
+	one.SetOne()
+	wrongSum.Add(&sum, &one)
+
+	// honest prover refuses to prove a wrong sum
+	_, err = Prove(poly, wrongSum, newTranscript(nbVars))
+	assert.ErrorIs(err, ErrWrongClaimedSum)
+
+	// verifier rejects a tampered claimed sum
+	_, err = Verify(wrongSum, nbVars, proof, newTranscript(nbVars))
+	assert.ErrorIs(err, ErrRoundSum)
+
+	// verifier rejects a tampered round polynomial
+	tampered := Proof{
+		RoundPolynomials: append([][2]fr.Element{}, proof.RoundPolynomials...),
+		FinalEvaluation:  proof.FinalEvaluation,
+	}
+	tampered.RoundPolynomials[2][1].Add(&tampered.RoundPolynomials[2][1], &one)
+	_, err = Verify(sum, nbVars, tampered, newTranscript(nbVars))
+	assert.ErrorIs(err, ErrRoundSum)
+
+	// verifier rejects a tampered final evaluation
+	tampered = proof
+	tampered.FinalEvaluation.Add(&tampered.FinalEvaluation, &one)
+	_, err = Verify(sum, nbVars, tampered, newTranscript(nbVars))
+	assert.ErrorIs(err, ErrFinalEvaluation)
+
+	// verifier rejects a proof for a different number of variables
+	_, err = Verify(sum, nbVars+1, proof, newTranscript(nbVars+1))
+	assert.ErrorIs(err, ErrProofSize)
+}
+
+func TestProveInvalidPolynomial(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{0, 1, 3, 6} {
+		poly := make(polynomial.MultiLin, size)
+		_, err := Prove(poly, fr.Element{}, newTranscript(1))
+		assert.ErrorIs(err, ErrInvalidPolynomial)
+	}
+}
+
+func BenchmarkProve(b *testing.B) {
+	const nbVars = 16
+	poly := randomMultiLin(nbVars)
+	sum := poly.Sum()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Prove(poly, sum, newTranscript(nbVars))
+	}
+}
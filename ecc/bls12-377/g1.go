@@ -7,6 +7,9 @@ package bls12377
 
 import (
 	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"io"
 	"math/big"
 	"runtime"
 	"sync/atomic"
@@ -32,6 +35,11 @@ type g1JacExtended struct {
 	X, Y, ZZ, ZZZ fp.Element
 }
 
+// g1Proj point in projective coordinates
+type g1Proj struct {
+	x, y, z fp.Element
+}
+
 // -------------------------------------------------------------------------------------------------
 // Affine coordinates
 
@@ -51,6 +59,9 @@ func (p *G1Affine) SetInfinity() *G1Affine {
 
 // ScalarMultiplication computes and returns p = [s]a
 // where p and a are affine points.
+//
+// s may be negative or larger than r: [s]a = -[|s|]a for s < 0, and for a in the
+// prime subgroup, the result only depends on |s| mod r. [0]a is the point at infinity.
 func (p *G1Affine) ScalarMultiplication(a *G1Affine, s *big.Int) *G1Affine {
 	if s.BitLen() >= g1ScalarMulChoose {
 		var _p G1Jac
@@ -63,6 +74,29 @@ func (p *G1Affine) ScalarMultiplication(a *G1Affine, s *big.Int) *G1Affine {
 	return p
 }
 
+// ScalarMultiplicationWithWindow computes and returns p = [s]a
+// where p and a are affine points, using a width-window wNAF recoding of
+// the two GLV sub-scalars of s.
+//
+// The window trades precomputation for loop additions: 2^(window-2) odd
+// multiples of the base are precomputed, and the main loop then does about
+// n/(window+1) additions for a n-bit scalar. Small windows suit small scalars,
+// larger ones pay off on full-size scalars. window <= 0 selects the same
+// strategy as ScalarMultiplication; other values are clamped to [2, 8].
+//
+// The result doesn't depend on window.
+func (p *G1Affine) ScalarMultiplicationWithWindow(a *G1Affine, s *big.Int, window int) *G1Affine {
+	if window <= 0 {
+		return p.ScalarMultiplication(a, s)
+	}
+	w := uint(min(max(window, 2), 8))
+	var _p G1Jac
+	_p.FromAffine(a)
+	_p.mulGLVWithWindow(&_p, s, w)
+	p.FromJacobian(&_p)
+	return p
+}
+
 // ScalarMultiplicationBase computes and returns p = [s]g
 // where g is the affine point generating the prime subgroup.
 func (p *G1Affine) ScalarMultiplicationBase(s *big.Int) *G1Affine {
@@ -76,6 +110,118 @@ func (p *G1Affine) ScalarMultiplicationBase(s *big.Int) *G1Affine {
 	return p
 }
 
+// Rand sets p to [s]g where g is the generator of the prime subgroup and s is
+// sampled uniformly in [0, r) from rng, and returns p.
+//
+// Exactly the bytes needed to encode a scalar are read per attempt, and
+// out-of-range candidates are rejected, so that s has no modulo bias.
+func (p *G1Affine) Rand(rng io.Reader) (*G1Affine, error) {
+	s, err := rand.Int(rng, fr.Modulus())
+	if err != nil {
+		return nil, err
+	}
+	return p.ScalarMultiplicationBase(s), nil
+}
+
+// ScalarMulXOnly returns the x-coordinate of [s]base, for protocols which only
+// use the abscissa of the result (e.g. ECDH). Compared to ScalarMultiplication,
+// it saves the computation of y when converting back to affine coordinates.
+// If [s]base is the point at infinity, it returns 0. p is not modified.
+func (p *G1Affine) ScalarMulXOnly(base G1Affine, s *fr.Element) fp.Element {
+	var x fp.Element
+	var _p G1Jac
+	var bs big.Int
+	_p.FromAffine(&base)
+	_p.ScalarMultiplication(&_p, s.BigInt(&bs))
+	if _p.Z.IsZero() {
+		return x
+	}
+
+	// x = X/Z²
+	x.Square(&_p.Z).
+		Inverse(&x).
+		Mul(&x, &_p.X)
+
+	return x
+}
+
+// ScalarMultiplicationConstantTime computes and returns p = [s]a
+// where p and a are affine points and a is in the prime-order subgroup.
+//
+// Unlike ScalarMultiplication, it doesn't use GLV nor signed digits: s is
+// scanned in fixed 4-bit windows over fr.Bits bits. Each window costs 4
+// doublings, a lookup in a table of [0..15]a that reads every entry with
+// constant-time selections, and 1 addition. The complete projective formulas
+// of https://eprint.iacr.org/2015/1060.pdf (algorithms 7 and 9) are used so
+// that there is no special case for the point at infinity or equal inputs,
+// and the result is converted to affine coordinates with a Fermat inversion.
+//
+// For s in [0, 2^fr.Bits), the scalar is decoded without branches and the
+// sequence of field operations doesn't depend on s. Other scalars are first
+// reduced modulo r with math/big, which is not constant time. Only the base
+// point a is assumed public.
+//
+// This is to be used with secret scalars (e.g. private keys) only: it is
+// about 2 to 3 times slower than ScalarMultiplication (GLV).
+func (p *G1Affine) ScalarMultiplicationConstantTime(a *G1Affine, s *big.Int) *G1Affine {
+	const w = 4
+	const nbWindows = (fr.Bits + w - 1) / w
+
+	// the base point is not secret
+	if a.IsInfinity() {
+		return p.SetInfinity()
+	}
+
+	var b3 fp.Element
+	b3.Set(&bCurveCoeff)
+	fp.MulBy3(&b3)
+
+	// table[i] = [i]a
+	var table [1 << w]g1Proj
+	table[0].setInfinity()
+	table[1].FromAffine(a)
+	for i := 2; i < len(table); i++ {
+		table[i].addComplete(&table[i-1], &table[1], &b3)
+	}
+
+	// a has order r, so the windows give [s]a for any s < 2^fr.Bits and s
+	// doesn't need to be reduced
+	if s.Sign() < 0 || s.BitLen() > fr.Bits {
+		s = new(big.Int).Mod(s, fr.Modulus())
+	}
+	var buf [fr.Bytes]byte
+	s.FillBytes(buf[:])
+	var k [fr.Limbs]uint64
+	for i := range k {
+		for j := 0; j < 8; j++ {
+			k[i] |= uint64(buf[fr.Bytes-1-8*i-j]) << (8 * j)
+		}
+	}
+
+	var acc, t g1Proj
+	acc.setInfinity()
+	for i := nbWindows - 1; i >= 0; i-- {
+		for j := 0; j < w; j++ {
+			acc.doubleComplete(&acc, &b3)
+		}
+		// w divides 64 so a window never spans two words
+		digit := int32((k[(i*w)/64] >> ((i * w) % 64)) & (1<<w - 1))
+		for j := range table {
+			t.selectIf(subtle.ConstantTimeEq(int32(j), digit), &t, &table[j])
+		}
+		acc.addComplete(&acc, &t, &b3)
+	}
+
+	// z⁻¹ = z^(p-2) is 0 for z = 0, which gives the affine infinity (0, 0)
+	var qMinus2 big.Int
+	qMinus2.Sub(fp.Modulus(), big.NewInt(2))
+	var zInv fp.Element
+	zInv.Exp(acc.z, &qMinus2)
+	p.X.Mul(&acc.x, &zInv)
+	p.Y.Mul(&acc.y, &zInv)
+	return p
+}
+
 // Add adds two points in affine coordinates.
 // It uses the Jacobian addition with a.Z=b.Z=1 and converts the result to affine coordinates.
 //
@@ -138,6 +284,17 @@ func (p *G1Affine) Double(a *G1Affine) *G1Affine {
 	return p
 }
 
+// Triple triples a point in affine coordinates.
+// It converts the point to Jacobian coordinates, triples it and converts it
+// back to affine coordinates, using a single inversion.
+func (p *G1Affine) Triple(a *G1Affine) *G1Affine {
+	var q G1Jac
+	q.FromAffine(a)
+	q.Triple(&q)
+	p.FromJacobian(&q)
+	return p
+}
+
 // Sub subtracts two points in affine coordinates.
 // It uses a similar approach to Add, but negates the second point before adding.
 func (p *G1Affine) Sub(a, b *G1Affine) *G1Affine {
@@ -293,6 +450,9 @@ func (p *G1Jac) Set(q *G1Jac) *G1Jac {
 }
 
 // Equal tests if two points in Jacobian coordinates are equal.
+// The comparison is projective: the coordinates are cross-multiplied by the Z
+// coordinates, so distinct representatives of the same point are equal and no
+// inversion is needed.
 func (p *G1Jac) Equal(q *G1Jac) bool {
 	// If one point is infinity, the other must also be infinity.
 	if p.Z.IsZero() {
@@ -392,6 +552,38 @@ func (p *G1Jac) SubAssign(q *G1Jac) *G1Jac {
 	return p
 }
 
+// AddComplete sets p to a+b in Jacobian coordinates, without branches.
+//
+// Unlike AddAssign, it has no special case for the point at infinity, a == b
+// or a == -b: the points are mapped to homogeneous projective coordinates
+// (X⋅Z : Y : Z³) and added with the complete formulas of
+// https://eprint.iacr.org/2015/1060.pdf (algorithm 7), which hold for all the
+// points of the odd-order subgroup. It is about 1.5 times slower than AddAssign
+// and is meant for constant-time code.
+func (p *G1Jac) AddComplete(a, b *G1Jac) *G1Jac {
+	var b3 fp.Element
+	b3.Set(&bCurveCoeff)
+	fp.MulBy3(&b3)
+
+	var q, r g1Proj
+	q.fromJacobian(a)
+	r.fromJacobian(b)
+	q.addComplete(&q, &r, &b3)
+
+	// (X:Y:Z) ↦ (X⋅Z, Y⋅Z², Z), the point at infinity (0:1:0) being sent to (1, 1, 0)
+	var zz fp.Element
+	zz.Square(&q.z)
+	p.X.Mul(&q.x, &q.z)
+	p.Y.Mul(&q.y, &zz)
+	p.Z.Set(&q.z)
+	var one fp.Element
+	one.SetOne()
+	isInfinity := q.z.IsZeroConstantTime()
+	p.X.Select(isInfinity, &p.X, &one)
+	p.Y.Select(isInfinity, &p.Y, &one)
+	return p
+}
+
 // Double sets p to [2]q in Jacobian coordinates.
 //
 // https://www.hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html#doubling-mdbl-2007-bl
@@ -581,6 +773,8 @@ func (p *G1Jac) Triple(q *G1Jac) *G1Jac {
 // where p and a are Jacobian points.
 // using the GLV technique.
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
+//
+// Negative scalars are supported, see [G1Affine.ScalarMultiplication].
 func (p *G1Jac) ScalarMultiplication(q *G1Jac, s *big.Int) *G1Jac {
 	if s.BitLen() >= g1ScalarMulChoose {
 		return p.mulGLV(q, s)
@@ -745,6 +939,17 @@ func (p *G1Jac) mulBySeed(q *G1Jac) *G1Jac {
 	return p
 }
 
+// Phi sets p to ϕ(a) where ϕ: (x,y) → (w x,y) is the GLV endomorphism,
+// w being a third root of unity, and returns p.
+//
+// On G1, ϕ acts as the scalar multiplication by λ, the scalar used to
+// decompose scalars in the GLV method.
+func (p *G1Affine) Phi(a *G1Affine) *G1Affine {
+	p.Set(a)
+	p.X.Mul(&p.X, &thirdRootOneG1)
+	return p
+}
+
 // phi sets p to ϕ(a) where ϕ: (x,y) → (w x,y),
 // where w is a third root of unity.
 func (p *G1Jac) phi(q *G1Jac) *G1Jac {
@@ -757,6 +962,13 @@ func (p *G1Jac) phi(q *G1Jac) *G1Jac {
 //
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
 func (p *G1Jac) mulGLV(q *G1Jac, s *big.Int) *G1Jac {
+	return p.mulGLVWithWindow(q, s, 5)
+}
+
+// mulGLVWithWindow computes the scalar multiplication using a windowed-GLV
+// method, where both sub-scalars are recoded in wNAF of the given width.
+// 2 <= window <= 8 is the wNAF window size.
+func (p *G1Jac) mulGLVWithWindow(q *G1Jac, s *big.Int, window uint) *G1Jac {
 
 	var res G1Jac
 	var q1, q2 G1Jac
@@ -779,12 +991,10 @@ func (p *G1Jac) mulGLV(q *G1Jac, s *big.Int) *G1Jac {
 		q2.Neg(&q2)
 	}
 
-	const wnafWindow = 5
-
 	var naf1 [fr.Bits + 1]int8
 	var naf2 [fr.Bits + 1]int8
-	nafLen1 := ecc.WnafDecomposition(&k[0], wnafWindow, naf1[:])
-	nafLen2 := ecc.WnafDecomposition(&k[1], wnafWindow, naf2[:])
+	nafLen1 := ecc.WnafDecomposition(&k[0], window, naf1[:])
+	nafLen2 := ecc.WnafDecomposition(&k[1], window, naf2[:])
 	maxLen := nafLen1
 	if nafLen2 > maxLen {
 		maxLen = nafLen2
@@ -794,7 +1004,15 @@ func (p *G1Jac) mulGLV(q *G1Jac, s *big.Int) *G1Jac {
 		return p
 	}
 
-	var q1Table, q2Table [8]G1Jac
+	// the default window of 5 fits in the stack buffers.
+	var q1Buf, q2Buf [8]G1Jac
+	q1Table, q2Table := q1Buf[:], q2Buf[:]
+	if tableSize := 1 << (window - 2); tableSize <= len(q1Buf) {
+		q1Table, q2Table = q1Table[:tableSize], q2Table[:tableSize]
+	} else {
+		q1Table = make([]G1Jac, tableSize)
+		q2Table = make([]G1Jac, tableSize)
+	}
 	q1Table[0].Set(&q1)
 	q2Table[0].Set(&q2)
 	var q1Two, q2Two G1Jac
@@ -1247,6 +1465,137 @@ func (p *g1JacExtended) doubleMixed(a *G1Affine) *g1JacExtended {
 	return p
 }
 
+// -------------------------------------------------------------------------------------------------
+// Homogenous projective coordinates
+
+// Set sets p to a in projective coordinates.
+func (p *g1Proj) Set(q *g1Proj) *g1Proj {
+	p.x, p.y, p.z = q.x, q.y, q.z
+	return p
+}
+
+// Neg sets p to the projective negative point -q = (q.X, -q.Y).
+func (p *g1Proj) Neg(q *g1Proj) *g1Proj {
+	*p = *q
+	p.y.Neg(&q.y)
+	return p
+}
+
+// FromAffine converts q in affine to p in projective coordinates.
+func (p *g1Proj) FromAffine(a *G1Affine) *g1Proj {
+	if a.X.IsZero() && a.Y.IsZero() {
+		p.z.SetZero()
+		p.x.SetOne()
+		p.y.SetOne()
+		return p
+	}
+	p.z.SetOne()
+	p.x.Set(&a.X)
+	p.y.Set(&a.Y)
+	return p
+}
+
+// fromJacobian sets p to q in homogeneous projective coordinates (X⋅Z : Y : Z³).
+// The point at infinity (X, Y, 0), including the zero value (0, 0, 0), is sent
+// to (0 : 1 : 0).
+func (p *g1Proj) fromJacobian(q *G1Jac) *g1Proj {
+	var zz fp.Element
+	zz.Square(&q.Z)
+	p.x.Mul(&q.X, &q.Z)
+	p.z.Mul(&zz, &q.Z)
+	var one fp.Element
+	one.SetOne()
+	p.y.Select(q.Z.IsZeroConstantTime(), &q.Y, &one)
+	return p
+}
+
+// setInfinity sets p to the point at infinity (0:1:0).
+func (p *g1Proj) setInfinity() *g1Proj {
+	p.x.SetZero()
+	p.y.SetOne()
+	p.z.SetZero()
+	return p
+}
+
+// selectIf is a constant-time conditional move: if c=0, p = q0, else p = q1.
+func (p *g1Proj) selectIf(c int, q0, q1 *g1Proj) *g1Proj {
+	p.x.Select(c, &q0.x, &q1.x)
+	p.y.Select(c, &q0.y, &q1.y)
+	p.z.Select(c, &q0.z, &q1.z)
+	return p
+}
+
+// addComplete sets p = q + r using the complete addition formulas for a=0
+// (algorithm 7 of https://eprint.iacr.org/2015/1060.pdf), with b3 = 3b.
+// It handles the point at infinity and q == r without branching, for points
+// in the odd-order subgroup.
+func (p *g1Proj) addComplete(q, r *g1Proj, b3 *fp.Element) *g1Proj {
+	var t0, t1, t2, t3, t4, x3, y3, z3 fp.Element
+	t0.Mul(&q.x, &r.x)
+	t1.Mul(&q.y, &r.y)
+	t2.Mul(&q.z, &r.z)
+	t3.Add(&q.x, &q.y)
+	t4.Add(&r.x, &r.y)
+	t3.Mul(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&q.y, &q.z)
+	x3.Add(&r.y, &r.z)
+	t4.Mul(&t4, &x3)
+	x3.Add(&t1, &t2)
+	t4.Sub(&t4, &x3)
+	x3.Add(&q.x, &q.z)
+	y3.Add(&r.x, &r.z)
+	x3.Mul(&x3, &y3)
+	y3.Add(&t0, &t2)
+	y3.Sub(&x3, &y3)
+	x3.Double(&t0)
+	t0.Add(&x3, &t0)
+	t2.Mul(b3, &t2)
+	z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	y3.Mul(b3, &y3)
+	x3.Mul(&t4, &y3)
+	t2.Mul(&t3, &t1)
+	x3.Sub(&t2, &x3)
+	y3.Mul(&y3, &t0)
+	t1.Mul(&t1, &z3)
+	y3.Add(&t1, &y3)
+	t0.Mul(&t0, &t3)
+	z3.Mul(&z3, &t4)
+	z3.Add(&z3, &t0)
+
+	p.x, p.y, p.z = x3, y3, z3
+	return p
+}
+
+// doubleComplete sets p = [2]q using the complete doubling formulas for a=0
+// (algorithm 9 of https://eprint.iacr.org/2015/1060.pdf), with b3 = 3b.
+func (p *g1Proj) doubleComplete(q *g1Proj, b3 *fp.Element) *g1Proj {
+	var t0, t1, t2, x3, y3, z3 fp.Element
+	t0.Square(&q.y)
+	z3.Double(&t0)
+	z3.Double(&z3)
+	z3.Double(&z3)
+	t1.Mul(&q.y, &q.z)
+	t2.Square(&q.z)
+	t2.Mul(b3, &t2)
+	x3.Mul(&t2, &z3)
+	y3.Add(&t0, &t2)
+	z3.Mul(&t1, &z3)
+	t1.Double(&t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	y3.Mul(&t0, &y3)
+	y3.Add(&x3, &y3)
+	t1.Mul(&q.x, &q.y)
+	x3.Mul(&t0, &t1)
+	x3.Double(&x3)
+
+	p.x, p.y, p.z = x3, y3, z3
+	return p
+}
+
 // BatchJacobianToAffineG1 converts points in Jacobian coordinates to Affine coordinates
 // performing a single field inversion using the Montgomery batch inversion trick.
 func BatchJacobianToAffineG1(points []G1Jac) []G1Affine {
@@ -1296,6 +1645,122 @@ func BatchJacobianToAffineG1(points []G1Jac) []G1Affine {
 	return result
 }
 
+// BatchNegG1 negates all points in place.
+// Points at infinity, encoded as (0,0), are left unchanged.
+func BatchNegG1(points []G1Affine) {
+	for i := range points {
+		points[i].Y.Neg(&points[i].Y)
+	}
+}
+
+// BatchNegatedG1 returns the negations of the points in a new slice,
+// leaving the input unchanged. Points at infinity, encoded as (0,0), are left unchanged.
+func BatchNegatedG1(points []G1Affine) []G1Affine {
+	result := make([]G1Affine, len(points))
+	for i := range points {
+		result[i].X = points[i].X
+		result[i].Y.Neg(&points[i].Y)
+	}
+	return result
+}
+
+// BatchSumG1 returns the sum of the points.
+//
+// The points are added pairwise in affine coordinates, level by level, so that
+// each level shares a single field inversion through the Montgomery batch
+// inversion trick: the sum costs O(n) multiplications and O(log n) inversions,
+// instead of the n mixed additions of a naive accumulation.
+// Pairs that would need a doubling or sum to infinity are set aside and handled
+// in Jacobian coordinates.
+func BatchSumG1(points []G1Affine) G1Jac {
+	// below this size, the inversion of a level costs more than the
+	// mixed additions it saves
+	const minBatchSize = 16
+
+	var res G1Jac
+	res.Set(&g1Infinity)
+
+	work := make([]G1Affine, 0, len(points))
+	for i := range points {
+		if !points[i].IsInfinity() {
+			work = append(work, points[i])
+		}
+	}
+
+	// denominators[i] = work[2i+1].X - work[2i].X, then their inverses
+	denominators := make([]fp.Element, len(work)/2)
+	prefix := make([]fp.Element, len(work)/2)
+
+	for len(work) >= minBatchSize {
+		nbPairs := len(work) / 2
+
+		// filter out the pairs with equal x coordinates
+		n := 0
+		for i := 0; i < nbPairs; i++ {
+			a, b := &work[2*i], &work[2*i+1]
+			if a.X.Equal(&b.X) {
+				if a.Y.Equal(&b.Y) {
+					// a + a
+					res.AddMixed(a)
+					res.AddMixed(a)
+				}
+				// else a + (-a) = 0
+				continue
+			}
+			work[2*n], work[2*n+1] = *a, *b
+			denominators[n].Sub(&b.X, &a.X)
+			n++
+		}
+		if len(work)%2 == 1 {
+			// the last point is carried to the next level
+			work[2*n] = work[len(work)-1]
+		}
+
+		if n > 0 {
+			// Montgomery batch inversion of the denominators
+			var accumulator fp.Element
+			accumulator.SetOne()
+			for i := 0; i < n; i++ {
+				prefix[i] = accumulator
+				accumulator.Mul(&accumulator, &denominators[i])
+			}
+			accumulator.Inverse(&accumulator)
+			for i := n - 1; i >= 0; i-- {
+				var inv fp.Element
+				inv.Mul(&accumulator, &prefix[i])
+				accumulator.Mul(&accumulator, &denominators[i])
+				denominators[i] = inv
+			}
+		}
+
+		// work[i] = work[2i] + work[2i+1]
+		// λ  = (y₂ - y₁) / (x₂ - x₁)
+		// x₃ = λ² - (x₁ + x₂)
+		// y₃ = λ⋅(x₁ - x₃) - y₁
+		var lambda, t fp.Element
+		var q G1Affine
+		for i := 0; i < n; i++ {
+			a, b := &work[2*i], &work[2*i+1]
+			lambda.Sub(&b.Y, &a.Y).Mul(&lambda, &denominators[i])
+			q.X.Square(&lambda).Sub(&q.X, &a.X).Sub(&q.X, &b.X)
+			t.Sub(&a.X, &q.X)
+			q.Y.Mul(&lambda, &t).Sub(&q.Y, &a.Y)
+			work[i] = q
+		}
+		if len(work)%2 == 1 {
+			work[n] = work[2*n]
+			n++
+		}
+		work = work[:n]
+	}
+
+	for i := range work {
+		res.AddMixed(&work[i])
+	}
+
+	return res
+}
+
 // BatchScalarMultiplicationG1 multiplies the same base by all scalars
 // and return resulting points in affine coordinates
 // uses a simple windowed-NAF-like multiplication algorithm.
@@ -1380,6 +1845,136 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 	return toReturnAff
 }
 
+// FixedBaseBGMWG1 holds a precomputed table for fixed-base scalar
+// multiplications with the Brickell–Gordon–McCurley–Wilson method, using signed h-bit
+// digits (see partitionScalars).
+//
+// The scalar is split in nbChunks = ⌈fr.Bits/h⌉ signed digits d_i ∈ [-2^{h-1}, 2^{h-1}]
+// and the digits are interleaved in v groups, so that
+//
+//	[s]base = Σ_{t<v} 2^{h·t} Σ_k [d_{k·v+t}] Q_k,  with Q_k = [2^{h·v·k}]base.
+//
+// Each inner sum is computed with 2^{h-1} buckets, and the v sums are combined with
+// h doublings each. The table holds ⌈nbChunks/v⌉ points and a multiplication costs about
+// nbChunks + v·2^h additions and h·(v-1) doublings: a larger h reduces the table size and
+// the number of digits, a larger v divides the table size at the cost of more doublings.
+// Since the buckets are reduced for each scalar, small windows (h ≈ 4 to 6) perform best.
+type FixedBaseBGMWG1 struct {
+	h, v  int
+	table []G1Affine
+}
+
+// NewFixedBaseBGMWG1 precomputes the table for base, with a window
+// size of h bits (2 ≤ h ≤ 15) and v ≥ 1 interleaved groups of digits.
+// base is expected to be in the prime-order subgroup, as scalars are reduced modulo r.
+func NewFixedBaseBGMWG1(base G1Affine, h, v int) (*FixedBaseBGMWG1, error) {
+	if h < 2 || h > 15 {
+		return nil, errors.New("invalid window size: h must be in [2, 15]")
+	}
+	nbChunks := int(computeNbChunks(uint64(h)))
+	if v < 1 || v > nbChunks {
+		return nil, errors.New("invalid number of groups: v must be in [1, nbChunks]")
+	}
+
+	// table[k] = [2^{h·v·k}]base
+	table := make([]G1Jac, (nbChunks+v-1)/v)
+	table[0].FromAffine(&base)
+	for k := 1; k < len(table); k++ {
+		table[k].Set(&table[k-1])
+		for j := 0; j < h*v; j++ {
+			table[k].DoubleAssign()
+		}
+	}
+
+	return &FixedBaseBGMWG1{
+		h:     h,
+		v:     v,
+		table: BatchJacobianToAffineG1(table),
+	}, nil
+}
+
+// ScalarMul returns [s]base.
+func (f *FixedBaseBGMWG1) ScalarMul(s *big.Int) G1Affine {
+	var e fr.Element
+	e.SetBigInt(s)
+	digits, _ := partitionScalars([]fr.Element{e}, uint64(f.h), 1)
+
+	var p G1Jac
+	f.mul(&p, digits, 1, f.newBuckets())
+
+	var res G1Affine
+	res.FromJacobian(&p)
+	return res
+}
+
+// BatchScalarMul returns [scalars[i]]base for each scalar, computed in parallel.
+func (f *FixedBaseBGMWG1) BatchScalarMul(scalars []fr.Element) []G1Affine {
+	digits, _ := partitionScalars(scalars, uint64(f.h), runtime.NumCPU())
+
+	res := make([]G1Jac, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		buckets := f.newBuckets()
+		for i := start; i < end; i++ {
+			f.mul(&res[i], digits[i:], len(scalars), buckets)
+		}
+	})
+
+	return BatchJacobianToAffineG1(res)
+}
+
+// newBuckets allocates enough buckets for the digits of partitionScalars, the last
+// window being possibly larger than h.
+func (f *FixedBaseBGMWG1) newBuckets() []G1Jac {
+	c := uint64(f.h)
+	return make([]G1Jac, 1<<(max(c, lastC(c))-1))
+}
+
+// mul sets p to the scalar multiplication for the digits d_i = digits[i*stride]
+// as returned by partitionScalars.
+func (f *FixedBaseBGMWG1) mul(p *G1Jac, digits []uint16, stride int, buckets []G1Jac) {
+	nbChunks := int(computeNbChunks(uint64(f.h)))
+
+	p.Set(&g1Infinity)
+	var runningSum, t G1Jac
+	var neg G1Affine
+	for g := f.v - 1; g >= 0; g-- {
+		if g != f.v-1 {
+			for j := 0; j < f.h; j++ {
+				p.DoubleAssign()
+			}
+		}
+
+		for j := range buckets {
+			buckets[j].Set(&g1Infinity)
+		}
+		maxBucket := -1
+		for k := 0; k*f.v+g < nbChunks; k++ {
+			digit := digits[(k*f.v+g)*stride]
+			if digit == 0 {
+				continue
+			}
+			bucketID := int(digit >> 1)
+			if digit&1 == 0 {
+				bucketID--
+				buckets[bucketID].AddMixed(&f.table[k])
+			} else {
+				neg.Neg(&f.table[k])
+				buckets[bucketID].AddMixed(&neg)
+			}
+			maxBucket = max(maxBucket, bucketID)
+		}
+
+		// Σ (j+1)·buckets[j]
+		runningSum.Set(&g1Infinity)
+		t.Set(&g1Infinity)
+		for j := maxBucket; j >= 0; j-- {
+			runningSum.AddAssign(&buckets[j])
+			t.AddAssign(&runningSum)
+		}
+		p.AddAssign(&t)
+	}
+}
+
 // batchAddG1Affine adds affine points using the Montgomery batch inversion trick.
 // Special cases (doubling, infinity) must be filtered out before this call.
 func batchAddG1Affine[TP pG1Affine, TPP ppG1Affine, TC cG1Affine](R *TPP, P *TP, batchSize int) {
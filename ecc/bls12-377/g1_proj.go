@@ -0,0 +1,128 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+)
+
+// G1Proj is a point in homogeneous projective coordinates (x=X/Z, y=Y/Z).
+// The point at infinity is (0:1:0). Any point with Z = 0, like the zero value
+// G1Proj{}, is treated as the point at infinity.
+//
+// Add and Double use the complete formulas of Renes, Costello and Batina
+// (https://eprint.iacr.org/2015/1060.pdf): they have no special case for the
+// point at infinity, for P + P or for P + (-P), and run in constant time. They
+// are complete for the points of the odd-order subgroup, which contains G1.
+type G1Proj struct {
+	X, Y, Z fp.Element
+}
+
+// Set sets p to q and returns p.
+func (p *G1Proj) Set(q *G1Proj) *G1Proj {
+	p.X, p.Y, p.Z = q.X, q.Y, q.Z
+	return p
+}
+
+// SetInfinity sets p to the point at infinity (0:1:0) and returns p.
+func (p *G1Proj) SetInfinity() *G1Proj {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetZero()
+	return p
+}
+
+// IsInfinity checks if p is the point at infinity.
+func (p *G1Proj) IsInfinity() bool {
+	return p.Z.IsZero()
+}
+
+// Equal tests if two points in projective coordinates are equal.
+func (p *G1Proj) Equal(q *G1Proj) bool {
+	// If one point is infinity, the other must also be infinity.
+	if p.Z.IsZero() {
+		return q.Z.IsZero()
+	}
+	if q.Z.IsZero() {
+		return false
+	}
+
+	// X₁⋅Z₂ = X₂⋅Z₁ and Y₁⋅Z₂ = Y₂⋅Z₁
+	var l, r fp.Element
+	l.Mul(&p.X, &q.Z)
+	r.Mul(&q.X, &p.Z)
+	if !l.Equal(&r) {
+		return false
+	}
+	l.Mul(&p.Y, &q.Z)
+	r.Mul(&q.Y, &p.Z)
+	return l.Equal(&r)
+}
+
+// Neg sets p to -q = (X:-Y:Z) and returns p.
+func (p *G1Proj) Neg(q *G1Proj) *G1Proj {
+	*p = *q
+	p.Y.Neg(&q.Y)
+	return p
+}
+
+// FromAffine converts a in affine to p in projective coordinates and returns p.
+func (p *G1Proj) FromAffine(a *G1Affine) *G1Proj {
+	if a.IsInfinity() {
+		return p.SetInfinity()
+	}
+	p.X.Set(&a.X)
+	p.Y.Set(&a.Y)
+	p.Z.SetOne()
+	return p
+}
+
+// ToAffine returns p in affine coordinates.
+func (p *G1Proj) ToAffine() G1Affine {
+	var a G1Affine
+	if p.Z.IsZero() {
+		return a
+	}
+	var zInv fp.Element
+	zInv.Inverse(&p.Z)
+	a.X.Mul(&p.X, &zInv)
+	a.Y.Mul(&p.Y, &zInv)
+	return a
+}
+
+// Add sets p = a + b using the complete addition law and returns p.
+func (p *G1Proj) Add(a, b *G1Proj) *G1Proj {
+	var b3 fp.Element
+	b3.Set(&bCurveCoeff)
+	fp.MulBy3(&b3)
+
+	q, r := a.normalized(), b.normalized()
+	q.addComplete(&q, &r, &b3)
+	p.X, p.Y, p.Z = q.x, q.y, q.z
+	return p
+}
+
+// Double sets p = [2]a using the complete doubling formulas and returns p.
+func (p *G1Proj) Double(a *G1Proj) *G1Proj {
+	var b3 fp.Element
+	b3.Set(&bCurveCoeff)
+	fp.MulBy3(&b3)
+
+	q := a.normalized()
+	q.doubleComplete(&q, &b3)
+	p.X, p.Y, p.Z = q.x, q.y, q.z
+	return p
+}
+
+// normalized returns p as a g1Proj. Points with Z = 0 are sent to (0:1:0) in
+// constant time, as the complete formulas don't hold for the other ones.
+func (p *G1Proj) normalized() g1Proj {
+	q := g1Proj{x: p.X, y: p.Y, z: p.Z}
+	var zero, one fp.Element
+	one.SetOne()
+	isInfinity := p.Z.IsZeroConstantTime()
+	q.x.Select(isInfinity, &q.x, &zero)
+	q.y.Select(isInfinity, &q.y, &one)
+	return q
+}
@@ -0,0 +1,177 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestG1Proj(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	// randomizes the projective representation of p
+	scale := func(p *G1Proj, f fp.Element) G1Proj {
+		var res G1Proj
+		res.X.Mul(&p.X, &f)
+		res.Y.Mul(&p.Y, &f)
+		res.Z.Mul(&p.Z, &f)
+		return res
+	}
+
+	var infinity G1Affine
+	var infinityProj G1Proj
+	infinityProj.SetInfinity()
+
+	properties.Property("[G1] FromAffine and ToAffine should round trip", prop.ForAll(
+		func(s big.Int, f fp.Element) bool {
+			var a G1Affine
+			var p G1Proj
+			a.ScalarMultiplication(&g1GenAff, &s)
+			p.FromAffine(&a)
+			p = scale(&p, f)
+			res := p.ToAffine()
+			return res.Equal(&a)
+		},
+		GenBigInt(),
+		GenFp(),
+	))
+
+	properties.Property("[G1] Add should match the affine addition", prop.ForAll(
+		func(s1, s2 big.Int, f1, f2 fp.Element) bool {
+			var a, b, expected G1Affine
+			var p, q G1Proj
+			a.ScalarMultiplication(&g1GenAff, &s1)
+			b.ScalarMultiplication(&g1GenAff, &s2)
+			expected.Add(&a, &b)
+
+			p.FromAffine(&a)
+			q.FromAffine(&b)
+			p = scale(&p, f1)
+			q = scale(&q, f2)
+			p.Add(&p, &q)
+			res := p.ToAffine()
+			return res.Equal(&expected)
+		},
+		GenBigInt(),
+		GenBigInt(),
+		GenFp(),
+		GenFp(),
+	))
+
+	properties.Property("[G1] Add and Double should agree on P + P", prop.ForAll(
+		func(s big.Int, f fp.Element) bool {
+			var a, expected G1Affine
+			var p, sum, double G1Proj
+			a.ScalarMultiplication(&g1GenAff, &s)
+			expected.Double(&a)
+
+			p.FromAffine(&a)
+			p = scale(&p, f)
+			sum.Add(&p, &p)
+			double.Double(&p)
+			res := double.ToAffine()
+			return sum.Equal(&double) && res.Equal(&expected)
+		},
+		GenBigInt(),
+		GenFp(),
+	))
+
+	properties.Property("[G1] P + (-P) should be the point at infinity", prop.ForAll(
+		func(s big.Int, f fp.Element) bool {
+			var a G1Affine
+			var p, pNeg G1Proj
+			a.ScalarMultiplication(&g1GenAff, &s)
+			p.FromAffine(&a)
+			p = scale(&p, f)
+			pNeg.Neg(&p)
+			p.Add(&p, &pNeg)
+			res := p.ToAffine()
+			return p.IsInfinity() && p.Equal(&infinityProj) && res.Equal(&infinity)
+		},
+		GenBigInt(),
+		GenFp(),
+	))
+
+	properties.Property("[G1] the point at infinity should be the neutral element", prop.ForAll(
+		func(s big.Int) bool {
+			var a G1Affine
+			var p, q, r G1Proj
+			a.ScalarMultiplication(&g1GenAff, &s)
+			p.FromAffine(&a)
+			q.Add(&p, &infinityProj)
+			r.Add(&infinityProj, &p)
+			return q.Equal(&p) && r.Equal(&p)
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG1ProjInfinity(t *testing.T) {
+	t.Parallel()
+
+	var infinity G1Affine
+	var p, q G1Proj
+	p.FromAffine(&infinity)
+	if !p.IsInfinity() || !p.Y.IsOne() || !p.X.IsZero() {
+		t.Fatal("FromAffine should map the point at infinity to (0:1:0)")
+	}
+	if res := p.ToAffine(); !res.IsInfinity() {
+		t.Fatal("ToAffine should map (0:1:0) to the point at infinity")
+	}
+	if q.Add(&p, &p); !q.IsInfinity() {
+		t.Fatal("∞ + ∞ should be ∞")
+	}
+	if q.Double(&p); !q.IsInfinity() {
+		t.Fatal("[2]∞ should be ∞")
+	}
+
+	// the zero value (0:0:0) is also the point at infinity
+	var zero G1Proj
+	if !zero.IsInfinity() || !zero.Equal(&p) {
+		t.Fatal("G1Proj{} should be the point at infinity")
+	}
+	var g G1Proj
+	g.FromAffine(&g1GenAff)
+	if q.Add(&zero, &g); !q.Equal(&g) {
+		t.Fatal("G1Proj{} + g should be g")
+	}
+	if q.Add(&g, &zero); !q.Equal(&g) {
+		t.Fatal("g + G1Proj{} should be g")
+	}
+	if q.Add(&zero, &zero); !q.IsInfinity() {
+		t.Fatal("G1Proj{} + G1Proj{} should be ∞")
+	}
+	if q.Double(&zero); !q.IsInfinity() {
+		t.Fatal("[2]G1Proj{} should be ∞")
+	}
+}
+
+func BenchmarkG1ProjAdd(b *testing.B) {
+	var a G1Affine
+	var p, q G1Proj
+	var s big.Int
+	s.SetUint64(5)
+	a.ScalarMultiplication(&g1GenAff, &s)
+	p.FromAffine(&a)
+	q.FromAffine(&g1GenAff)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Add(&p, &q)
+	}
+}
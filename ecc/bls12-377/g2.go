@@ -7,11 +7,14 @@ package bls12377
 
 import (
 	"crypto/rand"
+	"crypto/subtle"
+	"io"
 	"math/big"
 	"runtime"
 	"sync/atomic"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -56,6 +59,9 @@ func (p *G2Affine) SetInfinity() *G2Affine {
 
 // ScalarMultiplication computes and returns p = [s]a
 // where p and a are affine points.
+//
+// s may be negative or larger than r: [s]a = -[|s|]a for s < 0, and for a in the
+// prime subgroup, the result only depends on |s| mod r. [0]a is the point at infinity.
 func (p *G2Affine) ScalarMultiplication(a *G2Affine, s *big.Int) *G2Affine {
 	if s.BitLen() >= g2ScalarMulChoose {
 		var _p G2Jac
@@ -81,6 +87,95 @@ func (p *G2Affine) ScalarMultiplicationBase(s *big.Int) *G2Affine {
 	return p
 }
 
+// Rand sets p to [s]g where g is the generator of the prime subgroup and s is
+// sampled uniformly in [0, r) from rng, and returns p.
+//
+// Exactly the bytes needed to encode a scalar are read per attempt, and
+// out-of-range candidates are rejected, so that s has no modulo bias.
+func (p *G2Affine) Rand(rng io.Reader) (*G2Affine, error) {
+	s, err := rand.Int(rng, fr.Modulus())
+	if err != nil {
+		return nil, err
+	}
+	return p.ScalarMultiplicationBase(s), nil
+}
+
+// ScalarMultiplicationConstantTime computes and returns p = [s]a
+// where p and a are affine points and a is in the prime-order subgroup.
+//
+// Unlike ScalarMultiplication, it doesn't use GLV nor signed digits: s is
+// scanned in fixed 4-bit windows over fr.Bits bits. Each window costs 4
+// doublings, a lookup in a table of [0..15]a that reads every entry with
+// constant-time selections, and 1 addition. The complete projective formulas
+// of https://eprint.iacr.org/2015/1060.pdf (algorithms 7 and 9) are used so
+// that there is no special case for the point at infinity or equal inputs,
+// and the result is converted to affine coordinates with a Fermat inversion.
+//
+// For s in [0, 2^fr.Bits), the scalar is decoded without branches and the
+// sequence of field operations doesn't depend on s. Other scalars are first
+// reduced modulo r with math/big, which is not constant time. Only the base
+// point a is assumed public.
+//
+// This is to be used with secret scalars (e.g. private keys) only: it is
+// about 2 to 3 times slower than ScalarMultiplication (GLV).
+func (p *G2Affine) ScalarMultiplicationConstantTime(a *G2Affine, s *big.Int) *G2Affine {
+	const w = 4
+	const nbWindows = (fr.Bits + w - 1) / w
+
+	// the base point is not secret
+	if a.IsInfinity() {
+		return p.SetInfinity()
+	}
+
+	var b3 fptower.E2
+	b3.Double(&bTwistCurveCoeff).Add(&b3, &bTwistCurveCoeff)
+
+	// table[i] = [i]a
+	var table [1 << w]g2Proj
+	table[0].setInfinity()
+	table[1].FromAffine(a)
+	for i := 2; i < len(table); i++ {
+		table[i].addComplete(&table[i-1], &table[1], &b3)
+	}
+
+	// a has order r, so the windows give [s]a for any s < 2^fr.Bits and s
+	// doesn't need to be reduced
+	if s.Sign() < 0 || s.BitLen() > fr.Bits {
+		s = new(big.Int).Mod(s, fr.Modulus())
+	}
+	var buf [fr.Bytes]byte
+	s.FillBytes(buf[:])
+	var k [fr.Limbs]uint64
+	for i := range k {
+		for j := 0; j < 8; j++ {
+			k[i] |= uint64(buf[fr.Bytes-1-8*i-j]) << (8 * j)
+		}
+	}
+
+	var acc, t g2Proj
+	acc.setInfinity()
+	for i := nbWindows - 1; i >= 0; i-- {
+		for j := 0; j < w; j++ {
+			acc.doubleComplete(&acc, &b3)
+		}
+		// w divides 64 so a window never spans two words
+		digit := int32((k[(i*w)/64] >> ((i * w) % 64)) & (1<<w - 1))
+		for j := range table {
+			t.selectIf(subtle.ConstantTimeEq(int32(j), digit), &t, &table[j])
+		}
+		acc.addComplete(&acc, &t, &b3)
+	}
+
+	// z⁻¹ = z^(p²-2) is 0 for z = 0, which gives the affine infinity (0, 0)
+	var qMinus2 big.Int
+	qMinus2.Mul(fp.Modulus(), fp.Modulus()).Sub(&qMinus2, big.NewInt(2))
+	var zInv fptower.E2
+	zInv.Exp(acc.z, &qMinus2)
+	p.X.Mul(&acc.x, &zInv)
+	p.Y.Mul(&acc.y, &zInv)
+	return p
+}
+
 // Add adds two points in affine coordinates.
 // It uses the Jacobian addition with a.Z=b.Z=1 and converts the result to affine coordinates.
 //
@@ -143,6 +238,17 @@ func (p *G2Affine) Double(a *G2Affine) *G2Affine {
 	return p
 }
 
+// Triple triples a point in affine coordinates.
+// It converts the point to Jacobian coordinates, triples it and converts it
+// back to affine coordinates, using a single inversion.
+func (p *G2Affine) Triple(a *G2Affine) *G2Affine {
+	var q G2Jac
+	q.FromAffine(a)
+	q.Triple(&q)
+	p.FromJacobian(&q)
+	return p
+}
+
 // Sub subtracts two points in affine coordinates.
 // It uses a similar approach to Add, but negates the second point before adding.
 func (p *G2Affine) Sub(a, b *G2Affine) *G2Affine {
@@ -298,6 +404,9 @@ func (p *G2Jac) Set(q *G2Jac) *G2Jac {
 }
 
 // Equal tests if two points in Jacobian coordinates are equal.
+// The comparison is projective: the coordinates are cross-multiplied by the Z
+// coordinates, so distinct representatives of the same point are equal and no
+// inversion is needed.
 func (p *G2Jac) Equal(q *G2Jac) bool {
 	// If one point is infinity, the other must also be infinity.
 	if p.Z.IsZero() {
@@ -587,6 +696,8 @@ func (p *G2Jac) Triple(q *G2Jac) *G2Jac {
 // ScalarMultiplication computes and returns p = [s]a
 // where p and a are Jacobian points.
 // using a GLV-GLS method.
+//
+// Negative scalars are supported, see [G2Affine.ScalarMultiplication].
 func (p *G2Jac) ScalarMultiplication(q *G2Jac, s *big.Int) *G2Jac {
 	if s.BitLen() >= g2ScalarMulChoose {
 		return p.mulGLS(q, s)
@@ -753,6 +864,27 @@ func (p *G2Jac) psi(q *G2Jac) *G2Jac {
 	return p
 }
 
+// Psi sets p to ψ(a) = u o π o u⁻¹ where u:E'→E is the isomorphism from the twist to the curve E
+// and π is the Frobenius map, and returns p.
+//
+// On G2, ψ acts as the scalar multiplication by the field characteristic.
+func (p *G2Affine) Psi(a *G2Affine) *G2Affine {
+	p.X.Conjugate(&a.X).Mul(&p.X, &endo.u)
+	p.Y.Conjugate(&a.Y).Mul(&p.Y, &endo.v)
+	return p
+}
+
+// Phi sets p to ϕ(a) where ϕ: (x,y) → (w x,y) is the GLV endomorphism,
+// w being a third root of unity, and returns p.
+//
+// On G2, ϕ acts as the scalar multiplication by λ, the scalar used to
+// decompose scalars in the GLV method.
+func (p *G2Affine) Phi(a *G2Affine) *G2Affine {
+	p.Set(a)
+	p.X.MulByElement(&p.X, &thirdRootOneG2)
+	return p
+}
+
 // phi sets p to ϕ(a) where ϕ: (x,y) → (w x,y),
 // where w is a third root of unity.
 func (p *G2Jac) phi(q *G2Jac) *G2Jac {
@@ -765,6 +897,13 @@ func (p *G2Jac) phi(q *G2Jac) *G2Jac {
 //
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
 func (p *G2Jac) mulGLV(q *G2Jac, s *big.Int) *G2Jac {
+	return p.mulGLVWithWindow(q, s, 5)
+}
+
+// mulGLVWithWindow computes the scalar multiplication using a windowed-GLV
+// method, where both sub-scalars are recoded in wNAF of the given width.
+// 2 <= window <= 8 is the wNAF window size.
+func (p *G2Jac) mulGLVWithWindow(q *G2Jac, s *big.Int, window uint) *G2Jac {
 
 	var res G2Jac
 	var q1, q2 G2Jac
@@ -787,12 +926,10 @@ func (p *G2Jac) mulGLV(q *G2Jac, s *big.Int) *G2Jac {
 		q2.Neg(&q2)
 	}
 
-	const wnafWindow = 5
-
 	var naf1 [fr.Bits + 1]int8
 	var naf2 [fr.Bits + 1]int8
-	nafLen1 := ecc.WnafDecomposition(&k[0], wnafWindow, naf1[:])
-	nafLen2 := ecc.WnafDecomposition(&k[1], wnafWindow, naf2[:])
+	nafLen1 := ecc.WnafDecomposition(&k[0], window, naf1[:])
+	nafLen2 := ecc.WnafDecomposition(&k[1], window, naf2[:])
 	maxLen := nafLen1
 	if nafLen2 > maxLen {
 		maxLen = nafLen2
@@ -802,7 +939,15 @@ func (p *G2Jac) mulGLV(q *G2Jac, s *big.Int) *G2Jac {
 		return p
 	}
 
-	var q1Table, q2Table [8]G2Jac
+	// the default window of 5 fits in the stack buffers.
+	var q1Buf, q2Buf [8]G2Jac
+	q1Table, q2Table := q1Buf[:], q2Buf[:]
+	if tableSize := 1 << (window - 2); tableSize <= len(q1Buf) {
+		q1Table, q2Table = q1Table[:tableSize], q2Table[:tableSize]
+	} else {
+		q1Table = make([]G2Jac, tableSize)
+		q2Table = make([]G2Jac, tableSize)
+	}
 	q1Table[0].Set(&q1)
 	q2Table[0].Set(&q2)
 	var q1Two, q2Two G2Jac
@@ -1317,6 +1462,93 @@ func (p *g2Proj) FromAffine(a *G2Affine) *g2Proj {
 	return p
 }
 
+// setInfinity sets p to the point at infinity (0:1:0).
+func (p *g2Proj) setInfinity() *g2Proj {
+	p.x.SetZero()
+	p.y.SetOne()
+	p.z.SetZero()
+	return p
+}
+
+// selectIf is a constant-time conditional move: if c=0, p = q0, else p = q1.
+func (p *g2Proj) selectIf(c int, q0, q1 *g2Proj) *g2Proj {
+	p.x.Select(c, &q0.x, &q1.x)
+	p.y.Select(c, &q0.y, &q1.y)
+	p.z.Select(c, &q0.z, &q1.z)
+	return p
+}
+
+// addComplete sets p = q + r using the complete addition formulas for a=0
+// (algorithm 7 of https://eprint.iacr.org/2015/1060.pdf), with b3 = 3b.
+// It handles the point at infinity and q == r without branching, for points
+// in the odd-order subgroup.
+func (p *g2Proj) addComplete(q, r *g2Proj, b3 *fptower.E2) *g2Proj {
+	var t0, t1, t2, t3, t4, x3, y3, z3 fptower.E2
+	t0.Mul(&q.x, &r.x)
+	t1.Mul(&q.y, &r.y)
+	t2.Mul(&q.z, &r.z)
+	t3.Add(&q.x, &q.y)
+	t4.Add(&r.x, &r.y)
+	t3.Mul(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&q.y, &q.z)
+	x3.Add(&r.y, &r.z)
+	t4.Mul(&t4, &x3)
+	x3.Add(&t1, &t2)
+	t4.Sub(&t4, &x3)
+	x3.Add(&q.x, &q.z)
+	y3.Add(&r.x, &r.z)
+	x3.Mul(&x3, &y3)
+	y3.Add(&t0, &t2)
+	y3.Sub(&x3, &y3)
+	x3.Double(&t0)
+	t0.Add(&x3, &t0)
+	t2.Mul(b3, &t2)
+	z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	y3.Mul(b3, &y3)
+	x3.Mul(&t4, &y3)
+	t2.Mul(&t3, &t1)
+	x3.Sub(&t2, &x3)
+	y3.Mul(&y3, &t0)
+	t1.Mul(&t1, &z3)
+	y3.Add(&t1, &y3)
+	t0.Mul(&t0, &t3)
+	z3.Mul(&z3, &t4)
+	z3.Add(&z3, &t0)
+
+	p.x, p.y, p.z = x3, y3, z3
+	return p
+}
+
+// doubleComplete sets p = [2]q using the complete doubling formulas for a=0
+// (algorithm 9 of https://eprint.iacr.org/2015/1060.pdf), with b3 = 3b.
+func (p *g2Proj) doubleComplete(q *g2Proj, b3 *fptower.E2) *g2Proj {
+	var t0, t1, t2, x3, y3, z3 fptower.E2
+	t0.Square(&q.y)
+	z3.Double(&t0)
+	z3.Double(&z3)
+	z3.Double(&z3)
+	t1.Mul(&q.y, &q.z)
+	t2.Square(&q.z)
+	t2.Mul(b3, &t2)
+	x3.Mul(&t2, &z3)
+	y3.Add(&t0, &t2)
+	z3.Mul(&t1, &z3)
+	t1.Double(&t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	y3.Mul(&t0, &y3)
+	y3.Add(&x3, &y3)
+	t1.Mul(&q.x, &q.y)
+	x3.Mul(&t0, &t1)
+	x3.Double(&x3)
+
+	p.x, p.y, p.z = x3, y3, z3
+	return p
+}
+
 // BatchScalarMultiplicationG2 multiplies the same base by all scalars
 // and return resulting points in affine coordinates
 // uses a simple windowed-NAF-like multiplication algorithm.
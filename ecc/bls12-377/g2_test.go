@@ -14,6 +14,7 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
 
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/hash_to_curve"
 
@@ -45,6 +46,18 @@ func TestG2Endomorphism(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[BLS12-377] check that Phi(P) = lambdaGLV * P in affine coordinates", prop.ForAll(
+		func(a fptower.E2) bool {
+			var res1, res2 G2Affine
+			g := MapToG2(a)
+			res1.Phi(&g)
+			res2.ScalarMultiplication(&g, &lambdaGLV)
+
+			return res1.Equal(&res2) && res1.IsOnCurve()
+		},
+		GenE2(),
+	))
+
 	properties.Property("[BLS12-377] check that phi^2(P) + phi(P) + P = 0", prop.ForAll(
 		func(a fptower.E2) bool {
 			var p, res, tmp G2Jac
@@ -88,6 +101,18 @@ func TestG2Endomorphism(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[BLS12-377] check that Psi(P) = [p]P in affine coordinates", prop.ForAll(
+		func(a fptower.E2) bool {
+			var res1, res2 G2Affine
+			g := MapToG2(a)
+			res1.Psi(&g)
+			res2.ScalarMultiplication(&g, fp.Modulus())
+
+			return res1.Equal(&res2) && res1.IsOnCurve()
+		},
+		GenE2(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -206,6 +231,53 @@ func TestIsInSubGroupBatchG2(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG2Generator(t *testing.T) {
+	t.Parallel()
+
+	g := G2Generator()
+	if !g.Equal(&g2GenAff) {
+		t.Fatal("G2Generator should return the generator")
+	}
+
+	// mutating the returned point must not affect subsequent calls
+	g.Double(&g)
+	g.X.SetOne()
+	g2 := G2Generator()
+	if !g2.Equal(&g2GenAff) || !g2.IsInSubGroup() {
+		t.Fatal("G2Generator should return a copy of the generator")
+	}
+}
+
+func TestG2AffineRand(t *testing.T) {
+	t.Parallel()
+
+	var seed [32]byte
+	rng := rand.NewChaCha8(seed)
+
+	var p, q G2Affine
+	if _, err := p.Rand(rng); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Rand(rng); err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsInSubGroup() || !q.IsInSubGroup() {
+		t.Fatal("random point should be in the subgroup")
+	}
+	if p.Equal(&q) {
+		t.Fatal("two random points should differ")
+	}
+
+	// same seed, same point
+	var r G2Affine
+	if _, err := r.Rand(rand.NewChaCha8(seed)); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(&p) {
+		t.Fatal("random point should be deterministic given the rng state")
+	}
+}
+
 func TestG2Conversions(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -308,6 +380,32 @@ func TestG2Conversions(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[BLS12-377] [Jacobian] Representatives of distinct points should not be equal", prop.ForAll(
+		func(a, b fptower.E2) bool {
+			var neg, inf G2Jac
+			neg.Neg(&g2Gen)
+			op1 := fuzzG2Jac(&g2Gen, a)
+			op2 := fuzzG2Jac(&neg, b)
+			inf.Set(&op2)
+			inf.Z.SetZero()
+			return !op1.Equal(&op2) && !op2.Equal(&op1) && !op1.Equal(&inf) && !inf.Equal(&op1)
+		},
+		GenE2(),
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-377] [Jacobian] Representatives of infinity should be equal", prop.ForAll(
+		func(a, b fptower.E2) bool {
+			var op1, op2 G2Jac
+			op1.X, op1.Y = a, b
+			op2.X.SetOne()
+			op2.Y.SetOne()
+			return op1.Equal(&op2) && op2.Equal(&op1)
+		},
+		GenE2(),
+		GenE2(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -389,6 +487,7 @@ func TestG2AffineOps(t *testing.T) {
 		func(s fr.Element) bool {
 			g := g2GenAff
 			var gj G2Jac
+			gj.FromAffine(&g)
 			var nbs, bs big.Int
 			s.BigInt(&bs)
 			nbs.Neg(&bs)
@@ -416,6 +515,48 @@ func TestG2AffineOps(t *testing.T) {
 		GenFr(),
 	))
 
+	properties.Property("[BLS12-377] [-s]G = -[|s| mod r]G for scalars larger than r", prop.ForAll(
+		func(s, k fr.Element) bool {
+			g := g2GenAff
+			var gj G2Jac
+			gj.FromAffine(&g)
+
+			// bs = s + (k+1)·r·2¹²⁸, so that |bs| > r and |bs| mod r = s
+			var bs, nbs, kr big.Int
+			s.BigInt(&bs)
+			k.BigInt(&kr)
+			kr.Add(&kr, big.NewInt(1)).Mul(&kr, fr.Modulus()).Lsh(&kr, 128)
+			bs.Add(&bs, &kr)
+			nbs.Neg(&bs)
+
+			var expected G2Affine
+			expected.ScalarMultiplication(&g, s.BigInt(new(big.Int))).Neg(&expected)
+
+			var op1 G2Affine
+			var op2 G2Jac
+			op1.ScalarMultiplication(&g, &nbs)
+			op2.ScalarMultiplication(&gj, &nbs)
+			var op2Aff G2Affine
+			op2Aff.FromJacobian(&op2)
+			return op1.Equal(&expected) && op2Aff.Equal(&expected)
+		},
+		GenFr(),
+		GenFr(),
+	))
+
+	properties.Property("[BLS12-377] [0]G and [±r]G should be infinity", prop.ForAll(
+		func() bool {
+			g := g2GenAff
+			var nr big.Int
+			nr.Neg(fr.Modulus())
+			var op1, op2, op3 G2Affine
+			op1.ScalarMultiplication(&g, big.NewInt(0))
+			op2.ScalarMultiplication(&g, fr.Modulus())
+			op3.ScalarMultiplication(&g, &nr)
+			return op1.IsInfinity() && op2.IsInfinity() && op3.IsInfinity()
+		},
+	))
+
 	properties.Property("[BLS12-377] [Jacobian] Add should call double when adding the same point", prop.ForAll(
 		func(a, b fptower.E2) bool {
 			fop1 := fuzzG2Jac(&g2Gen, a)
@@ -571,6 +712,32 @@ func TestG2AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-377] ScalarMultiplicationConstantTime should output the same result as ScalarMultiplication", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var base, op1, op2, op3, infinity G2Affine
+			var sInt big.Int
+			base.ScalarMultiplication(&g2GenAff, big.NewInt(7))
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&base, &sInt)
+			op2.ScalarMultiplicationConstantTime(&base, &sInt)
+
+			// s, s+r and s-r, [0]base and [s]infinity
+			sInt.Add(&sInt, fr.Modulus())
+			op3.ScalarMultiplicationConstantTime(&base, &sInt)
+			ok := op1.Equal(&op2) && op1.Equal(&op3)
+			sInt.Sub(&sInt, fr.Modulus()).Sub(&sInt, fr.Modulus())
+			op3.ScalarMultiplicationConstantTime(&base, &sInt)
+			ok = ok && op1.Equal(&op3)
+			op3.ScalarMultiplicationConstantTime(&base, big.NewInt(0))
+			ok = ok && op3.Equal(&infinity)
+			op3.ScalarMultiplicationConstantTime(&infinity, &sInt)
+			return ok && op3.Equal(&infinity)
+
+		},
+		genScalar,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -606,6 +773,34 @@ func TestG2CofactorClearing(t *testing.T) {
 			return point.IsOnCurve() && pointCleared.IsInSubGroup() && !pointCleared.Equal(&infinity)
 		},
 	))
+
+	properties.Property("[BLS12-377] Clearing the cofactor of a random affine point should set it in the r-torsion", prop.ForAll(
+		func() bool {
+			var a, x, b fptower.E2
+			a.MustSetRandom()
+
+			x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			for x.Legendre() != 1 {
+				a.MustSetRandom()
+				x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			}
+
+			b.Sqrt(&x)
+			var point, pointCleared, expected G2Affine
+			point.X.Set(&a)
+			point.Y.Set(&b)
+			pointCleared.ClearCofactor(&point)
+
+			// must match the Jacobian version
+			var pointJac G2Jac
+			pointJac.FromAffine(&point)
+			pointJac.ClearCofactor(&pointJac)
+			expected.FromJacobian(&pointJac)
+
+			return point.IsOnCurve() &&
+				pointCleared.IsInSubGroup() && !pointCleared.IsInfinity() && pointCleared.Equal(&expected)
+		},
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -685,6 +880,59 @@ func TestG2JacTriple(t *testing.T) {
 	}
 }
 
+func TestG2AffineDoubleTriple(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-377] affine Double and Triple should match the Jacobian ones", prop.ForAll(
+		func(s fr.Element) bool {
+			var a, double, triple, expected G2Affine
+			a.ScalarMultiplication(&g2GenAff, s.BigInt(new(big.Int)))
+			var j G2Jac
+			j.FromAffine(&a)
+
+			double.Double(&a)
+			var jd G2Jac
+			jd.Double(&j)
+			expected.FromJacobian(&jd)
+			if !double.Equal(&expected) {
+				return false
+			}
+
+			triple.Triple(&a)
+			var jt G2Jac
+			jt.Triple(&j)
+			expected.FromJacobian(&jt)
+			if !triple.Equal(&expected) {
+				return false
+			}
+
+			// in place
+			a.Triple(&a)
+			return a.Equal(&triple)
+		},
+		GenFr(),
+	))
+
+	properties.Property("[BLS12-377] affine Double and Triple of infinity should be infinity", prop.ForAll(
+		func() bool {
+			var inf, double, triple G2Affine
+			double.Double(&inf)
+			triple.Triple(&inf)
+			return double.IsInfinity() && triple.IsInfinity()
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func BenchmarkG2JacTriple(b *testing.B) {
 	var a G2Jac
 	a.Set(&g2Gen)
@@ -777,6 +1025,16 @@ func BenchmarkBatchAddG2Affine(b *testing.B) {
 	}
 }
 
+func BenchmarkG2AffineRand(b *testing.B) {
+	var p G2Affine
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Rand(crand.Reader); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkG2AffineBatchScalarMultiplication(b *testing.B) {
 	// ensure every words of the scalars are filled
 	var mixer fr.Element
@@ -849,6 +1107,27 @@ func BenchmarkG2JacScalarMultiplicationMethod(b *testing.B) {
 	}
 }
 
+func BenchmarkG2AffineScalarMultiplicationConstantTime(b *testing.B) {
+	scalar, err := crand.Int(crand.Reader, fr.Modulus())
+	if err != nil {
+		b.Fatalf("failed to generate random scalar: %v", err)
+	}
+
+	var res G2Affine
+	b.Run("GLV", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ScalarMultiplication(&g2GenAff, scalar)
+		}
+	})
+	b.Run("constant-time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ScalarMultiplicationConstantTime(&g2GenAff, scalar)
+		}
+	})
+}
+
 func BenchmarkG2AffineCofactorClearing(b *testing.B) {
 	var a G2Jac
 	a.Set(&g2Gen)
@@ -12,7 +12,11 @@ func (z *E12) nSquareCompressed(n int) {
 	}
 }
 
-// Expt set z to x^t in E12 and return z
+// Expt set z to xᵗ in E12 and return z, where t = 9586122913090633729 is the
+// seed of the curve. Since t is positive, no conjugation is needed.
+//
+// x must be in the cyclotomic subgroup (e.g. the output of the easy part of the
+// final exponentiation), as the chain uses cyclotomic squarings.
 func (z *E12) Expt(x *E12) *E12 {
 	// const tAbsVal uint64 = 9586122913090633729
 	// tAbsVal in binary: 1000010100001000110000000000000000000000000000000000000000000001
@@ -94,6 +94,20 @@ func (z *E2) norm(x *fp.Element) {
 	x.Square(&z.A0).Add(x, &tmp)
 }
 
+// Norm returns the norm of z, that is z⋅z̄ = A0² - u²⋅A1² = A0² + 5⋅A1²
+func (z *E2) Norm() fp.Element {
+	var res fp.Element
+	z.norm(&res)
+	return res
+}
+
+// Trace returns the trace of z, that is z + z̄ = 2⋅A0
+func (z *E2) Trace() fp.Element {
+	var res fp.Element
+	res.Double(&z.A0)
+	return res
+}
+
 // MulBybTwistCurveCoeff multiplies by 1/(0,1)
 func (z *E2) MulBybTwistCurveCoeff(x *E2) *E2 {
 
@@ -0,0 +1,95 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package fptower
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestE2NormTrace(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE2()
+	genB := GenE2()
+
+	// u² = -5
+	var uSquare fp.Element
+	uSquare.SetInt64(-5)
+
+	properties.Property("[BLS12-377] Norm should be A0² - u²⋅A1²", prop.ForAll(
+		func(a *E2) bool {
+			var expected, tmp fp.Element
+			expected.Square(&a.A0)
+			tmp.Square(&a.A1).Mul(&tmp, &uSquare)
+			expected.Sub(&expected, &tmp)
+			norm := a.Norm()
+			return norm.Equal(&expected)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-377] Norm should be the product of conjugates", prop.ForAll(
+		func(a *E2) bool {
+			var c E2
+			c.Conjugate(a).Mul(&c, a)
+			norm := a.Norm()
+			return c.A1.IsZero() && norm.Equal(&c.A0)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-377] Norm should be multiplicative", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Mul(a, b)
+			na, nb, nc := a.Norm(), b.Norm(), c.Norm()
+			na.Mul(&na, &nb)
+			return nc.Equal(&na)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[BLS12-377] Trace should be 2⋅A0", prop.ForAll(
+		func(a *E2) bool {
+			var expected fp.Element
+			expected.Add(&a.A0, &a.A0)
+			trace := a.Trace()
+			return trace.Equal(&expected)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-377] Trace should be the sum of conjugates and additive", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Conjugate(a).Add(&c, a)
+			ta := a.Trace()
+			if !c.A1.IsZero() || !ta.Equal(&c.A0) {
+				return false
+			}
+			d.Add(a, b)
+			tb, td := b.Trace(), d.Trace()
+			ta.Add(&ta, &tb)
+			return td.Equal(&ta)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
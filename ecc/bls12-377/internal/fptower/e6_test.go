@@ -274,6 +274,22 @@ func TestE6Ops(t *testing.T) {
 		genE2,
 	))
 
+	properties.Property("[BLS12-377] SetStringChecked should round-trip decimal strings", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			if _, err := b.SetStringChecked(
+				a.B0.A0.String(), a.B0.A1.String(),
+				a.B1.A0.String(), a.B1.A1.String(),
+				a.B2.A0.String(), a.B2.A1.String(),
+			); err != nil {
+				return false
+			}
+			_, err := b.SetStringChecked("1", "2", "3", "4", "5", "0xg")
+			return b.Equal(a) && err != nil
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -348,5 +364,26 @@ func TestE6Div(t *testing.T) {
 		genB,
 	))
 
+	properties.Property("[BLS12-377] dividing by zero should output zero", prop.ForAll(
+		func(a *E6) bool {
+			var c, zero E6
+			c.Div(a, &zero)
+			return c.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-377] dividing a non-zero element by itself should output one", prop.ForAll(
+		func(a *E6) bool {
+			if a.IsZero() {
+				return true
+			}
+			var c E6
+			c.Div(a, a)
+			return c.IsOne()
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
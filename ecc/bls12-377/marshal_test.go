@@ -8,10 +8,14 @@ package bls12377
 import (
 	"bytes"
 	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
 	"math/big"
 	"math/rand/v2"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/leanovate/gopter"
@@ -166,6 +170,92 @@ func TestEncoder(t *testing.T) {
 
 }
 
+func TestDecodeG1Slice(t *testing.T) {
+	t.Parallel()
+
+	const n = 100
+	var s fr.Element
+	s.MustSetRandom()
+	scalars := make([]fr.Element, n)
+	for i := range scalars {
+		scalars[i].SetUint64(uint64(i)).Mul(&scalars[i], &s)
+	}
+	points := BatchScalarMultiplicationG1(&g1GenAff, scalars)
+	points[1].SetInfinity()
+
+	// mix compressed and uncompressed encodings
+	encode := func(points []G1Affine) []byte {
+		var buf bytes.Buffer
+		for i := range points {
+			if i%2 == 0 {
+				b := points[i].Bytes()
+				buf.Write(b[:])
+			} else {
+				b := points[i].RawBytes()
+				buf.Write(b[:])
+			}
+		}
+		return buf.Bytes()
+	}
+	data := encode(points)
+
+	for _, check := range []SubGroupCheckMode{FullCheck, BatchCheck, NoCheck} {
+		decoded, err := DecodeG1Slice(bytes.NewReader(data), n, check)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(points, decoded) {
+			t.Fatalf("mode %d: decoded points don't match", check)
+		}
+	}
+
+	if _, err := DecodeG1Slice(bytes.NewReader(data), n+1, FullCheck); err == nil {
+		t.Fatal("expected an error on a short stream")
+	}
+
+	// find a point on the curve which is not in the subgroup
+	var offSubGroup G1Affine
+	found := false
+	for x := uint64(1); x < 256 && !found; x++ {
+		var rhs fp.Element
+		offSubGroup.X.SetUint64(x)
+		rhs.Square(&offSubGroup.X).Mul(&rhs, &offSubGroup.X).Add(&rhs, &bCurveCoeff)
+		if offSubGroup.Y.Sqrt(&rhs) == nil {
+			continue
+		}
+		found = offSubGroup.IsOnCurve() && !offSubGroup.IsInSubGroup()
+	}
+	if !found {
+		t.Skip("no point outside of the subgroup found, G1 cofactor is likely 1")
+	}
+
+	const bad = 42
+	for _, bad := range []int{bad, bad + 1} { // compressed and uncompressed
+		invalid := make([]G1Affine, n)
+		copy(invalid, points)
+		invalid[bad] = offSubGroup
+		data := encode(invalid)
+
+		_, err := DecodeG1Slice(bytes.NewReader(data), n, FullCheck)
+		if err == nil {
+			t.Fatal("FullCheck should reject a point outside of the subgroup")
+		}
+		if !strings.Contains(err.Error(), fmt.Sprintf("point %d:", bad)) {
+			t.Fatalf("FullCheck error should identify the point %d: %v", bad, err)
+		}
+		if _, err = DecodeG1Slice(bytes.NewReader(data), n, BatchCheck); err == nil {
+			t.Fatal("BatchCheck should reject a point outside of the subgroup")
+		}
+		decoded, err := DecodeG1Slice(bytes.NewReader(data), n, NoCheck)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !decoded[bad].Equal(&offSubGroup) {
+			t.Fatal("NoCheck should decode the point outside of the subgroup")
+		}
+	}
+}
+
 func TestIsCompressed(t *testing.T) {
 	t.Parallel()
 	var g1Inf, g1 G1Affine
@@ -252,6 +342,58 @@ func TestG1AffineInvalidBitMask(t *testing.T) {
 	}
 }
 
+func TestG1AffineJSON(t *testing.T) {
+	t.Parallel()
+
+	type S struct {
+		A G1Affine
+		B *G1Affine
+		C *G1Affine
+	}
+
+	var s S
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	s.A.ScalarMultiplication(&g1GenAff, &k)
+	s.C = new(G1Affine) // infinity
+
+	encoded, err := json.Marshal(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded S
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !s.A.Equal(&decoded.A) || decoded.B != nil || !decoded.C.IsInfinity() {
+		t.Fatal("point -> json -> point round trip failed")
+	}
+
+	// uncompressed form is accepted too
+	raw := s.A.RawBytes()
+	var p G1Affine
+	if err := json.Unmarshal([]byte("\"0x"+hex.EncodeToString(raw[:])+"\""), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&s.A) {
+		t.Fatal("uncompressed json -> point failed")
+	}
+
+	// malformed inputs are rejected
+	compressed := s.A.Bytes()
+	for _, in := range []string{
+		"42",
+		"\"" + hex.EncodeToString(compressed[:]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:len(compressed)-1]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:]) + "00\"",
+		"\"0xzz\"",
+	} {
+		if err := json.Unmarshal([]byte(in), &p); err == nil {
+			t.Fatalf("expected error when decoding %s", in)
+		}
+	}
+}
+
 func TestG1AffineSerialization(t *testing.T) {
 	t.Parallel()
 	// test round trip serialization of infinity
@@ -342,7 +484,67 @@ func TestG1AffineSerialization(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G1] Affine SetBytesUnchecked should output the same result as SetBytes", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, checked, unchecked G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			raw, compressed := start.RawBytes(), start.Bytes()
+			for _, buf := range [][]byte{raw[:], compressed[:]} {
+				n1, err := checked.SetBytes(buf)
+				if err != nil {
+					return false
+				}
+				n2, err := unchecked.SetBytesUnchecked(buf)
+				if err != nil {
+					return false
+				}
+				if n1 != n2 || !checked.Equal(&unchecked) {
+					return false
+				}
+			}
+			return true
+		},
+		GenFp(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// a point off the curve is only accepted by SetBytesUnchecked
+	var p, q G1Affine
+	p.Set(&g1GenAff)
+	p.Y.Double(&p.Y)
+	buf := p.RawBytes()
+	if _, err := q.SetBytes(buf[:]); err == nil {
+		t.Fatal("SetBytes should reject a point off the curve")
+	}
+	if _, err := q.SetBytesUnchecked(buf[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("SetBytesUnchecked should set the coordinates as is")
+	}
+}
+
+func BenchmarkG1AffineSetBytes(b *testing.B) {
+	var p G1Affine
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	p.ScalarMultiplication(&g1GenAff, &k)
+	raw := p.RawBytes()
+
+	b.Run("checked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytes(raw[:])
+		}
+	})
+	b.Run("unchecked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytesUnchecked(raw[:])
+		}
+	})
 }
 
 func TestG2AffineInvalidBitMask(t *testing.T) {
@@ -365,6 +567,58 @@ func TestG2AffineInvalidBitMask(t *testing.T) {
 	}
 }
 
+func TestG2AffineJSON(t *testing.T) {
+	t.Parallel()
+
+	type S struct {
+		A G2Affine
+		B *G2Affine
+		C *G2Affine
+	}
+
+	var s S
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	s.A.ScalarMultiplication(&g2GenAff, &k)
+	s.C = new(G2Affine) // infinity
+
+	encoded, err := json.Marshal(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded S
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !s.A.Equal(&decoded.A) || decoded.B != nil || !decoded.C.IsInfinity() {
+		t.Fatal("point -> json -> point round trip failed")
+	}
+
+	// uncompressed form is accepted too
+	raw := s.A.RawBytes()
+	var p G2Affine
+	if err := json.Unmarshal([]byte("\"0x"+hex.EncodeToString(raw[:])+"\""), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&s.A) {
+		t.Fatal("uncompressed json -> point failed")
+	}
+
+	// malformed inputs are rejected
+	compressed := s.A.Bytes()
+	for _, in := range []string{
+		"42",
+		"\"" + hex.EncodeToString(compressed[:]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:len(compressed)-1]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:]) + "00\"",
+		"\"0xzz\"",
+	} {
+		if err := json.Unmarshal([]byte(in), &p); err == nil {
+			t.Fatalf("expected error when decoding %s", in)
+		}
+	}
+}
+
 func TestG2AffineSerialization(t *testing.T) {
 	t.Parallel()
 	// test round trip serialization of infinity
@@ -455,7 +709,67 @@ func TestG2AffineSerialization(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G2] Affine SetBytesUnchecked should output the same result as SetBytes", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, checked, unchecked G2Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			raw, compressed := start.RawBytes(), start.Bytes()
+			for _, buf := range [][]byte{raw[:], compressed[:]} {
+				n1, err := checked.SetBytes(buf)
+				if err != nil {
+					return false
+				}
+				n2, err := unchecked.SetBytesUnchecked(buf)
+				if err != nil {
+					return false
+				}
+				if n1 != n2 || !checked.Equal(&unchecked) {
+					return false
+				}
+			}
+			return true
+		},
+		GenFp(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// a point off the curve is only accepted by SetBytesUnchecked
+	var p, q G2Affine
+	p.Set(&g2GenAff)
+	p.Y.Double(&p.Y)
+	buf := p.RawBytes()
+	if _, err := q.SetBytes(buf[:]); err == nil {
+		t.Fatal("SetBytes should reject a point off the curve")
+	}
+	if _, err := q.SetBytesUnchecked(buf[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("SetBytesUnchecked should set the coordinates as is")
+	}
+}
+
+func BenchmarkG2AffineSetBytes(b *testing.B) {
+	var p G2Affine
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	p.ScalarMultiplication(&g2GenAff, &k)
+	raw := p.RawBytes()
+
+	b.Run("checked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytes(raw[:])
+		}
+	})
+	b.Run("unchecked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytesUnchecked(raw[:])
+		}
+	})
 }
 
 // define Gopters generators
@@ -17,47 +17,61 @@ func processChunkG1Jacobian[B ibg1JacExtended](chunk uint64,
 		<-sem
 	}
 
-	var buckets B
-	for i := 0; i < len(buckets); i++ {
-		buckets[i].SetInfinity()
+	var total [1]g1JacExtended
+	accumulateChunkG1Jacobian[B](points, digits, []int{0, len(digits)}, total[:])
+
+	if sem != nil {
+		// release a token to the semaphore
+		// before sending to chRes
+		sem <- struct{}{}
 	}
 
-	// for each scalars, get the digit corresponding to the chunk we're processing.
-	for i, digit := range digits {
-		if digit == 0 {
-			continue
-		}
+	chRes <- total[0]
+}
 
-		// if msbWindow bit is set, we need to subtract
-		if digit&1 == 0 {
-			// add
-			buckets[(digit>>1)-1].addMixed(&points[i])
-		} else {
-			// sub
-			buckets[(digit >> 1)].subMixed(&points[i])
-		}
-	}
+// accumulateChunkG1Jacobian is the bucket accumulation of processChunkG1Jacobian,
+// for the digits of one or several scalar vectors: the vector k has its digits in
+// digits[offsets[k]:offsets[k+1]], and its partial sum is stored in totals[k].
+// The buckets are reused from one vector to the next.
+func accumulateChunkG1Jacobian[B ibg1JacExtended](points []G1Affine,
+	digits []uint16,
+	offsets []int,
+	totals []g1JacExtended) {
 
-	// reduce buckets into total
-	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var buckets B
+	for k := range totals {
+		for i := 0; i < len(buckets); i++ {
+			buckets[i].SetInfinity()
+		}
 
-	var runningSum, total g1JacExtended
-	runningSum.SetInfinity()
-	total.SetInfinity()
-	for k := len(buckets) - 1; k >= 0; k-- {
-		if !buckets[k].IsInfinity() {
-			runningSum.add(&buckets[k])
+		// for each scalars, get the digit corresponding to the chunk we're processing.
+		for i, digit := range digits[offsets[k]:offsets[k+1]] {
+			if digit == 0 {
+				continue
+			}
+
+			// if msbWindow bit is set, we need to subtract
+			if digit&1 == 0 {
+				// add
+				buckets[(digit>>1)-1].addMixed(&points[i])
+			} else {
+				// sub
+				buckets[(digit >> 1)].subMixed(&points[i])
+			}
 		}
-		total.add(&runningSum)
-	}
 
-	if sem != nil {
-		// release a token to the semaphore
-		// before sending to chRes
-		sem <- struct{}{}
+		// reduce buckets into total
+		// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+		var runningSum g1JacExtended
+		runningSum.SetInfinity()
+		totals[k].SetInfinity()
+		for l := len(buckets) - 1; l >= 0; l-- {
+			if !buckets[l].IsInfinity() {
+				runningSum.add(&buckets[l])
+			}
+			totals[k].add(&runningSum)
+		}
 	}
-
-	chRes <- total
 }
 
 // we declare the buckets as fixed-size array types
@@ -106,47 +120,61 @@ func processChunkG2Jacobian[B ibg2JacExtended](chunk uint64,
 		<-sem
 	}
 
-	var buckets B
-	for i := 0; i < len(buckets); i++ {
-		buckets[i].SetInfinity()
+	var total [1]g2JacExtended
+	accumulateChunkG2Jacobian[B](points, digits, []int{0, len(digits)}, total[:])
+
+	if sem != nil {
+		// release a token to the semaphore
+		// before sending to chRes
+		sem <- struct{}{}
 	}
 
-	// for each scalars, get the digit corresponding to the chunk we're processing.
-	for i, digit := range digits {
-		if digit == 0 {
-			continue
-		}
+	chRes <- total[0]
+}
 
-		// if msbWindow bit is set, we need to subtract
-		if digit&1 == 0 {
-			// add
-			buckets[(digit>>1)-1].addMixed(&points[i])
-		} else {
-			// sub
-			buckets[(digit >> 1)].subMixed(&points[i])
-		}
-	}
+// accumulateChunkG2Jacobian is the bucket accumulation of processChunkG2Jacobian,
+// for the digits of one or several scalar vectors: the vector k has its digits in
+// digits[offsets[k]:offsets[k+1]], and its partial sum is stored in totals[k].
+// The buckets are reused from one vector to the next.
+func accumulateChunkG2Jacobian[B ibg2JacExtended](points []G2Affine,
+	digits []uint16,
+	offsets []int,
+	totals []g2JacExtended) {
 
-	// reduce buckets into total
-	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var buckets B
+	for k := range totals {
+		for i := 0; i < len(buckets); i++ {
+			buckets[i].SetInfinity()
+		}
 
-	var runningSum, total g2JacExtended
-	runningSum.SetInfinity()
-	total.SetInfinity()
-	for k := len(buckets) - 1; k >= 0; k-- {
-		if !buckets[k].IsInfinity() {
-			runningSum.add(&buckets[k])
+		// for each scalars, get the digit corresponding to the chunk we're processing.
+		for i, digit := range digits[offsets[k]:offsets[k+1]] {
+			if digit == 0 {
+				continue
+			}
+
+			// if msbWindow bit is set, we need to subtract
+			if digit&1 == 0 {
+				// add
+				buckets[(digit>>1)-1].addMixed(&points[i])
+			} else {
+				// sub
+				buckets[(digit >> 1)].subMixed(&points[i])
+			}
 		}
-		total.add(&runningSum)
-	}
 
-	if sem != nil {
-		// release a token to the semaphore
-		// before sending to chRes
-		sem <- struct{}{}
+		// reduce buckets into total
+		// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+		var runningSum g2JacExtended
+		runningSum.SetInfinity()
+		totals[k].SetInfinity()
+		for l := len(buckets) - 1; l >= 0; l-- {
+			if !buckets[l].IsInfinity() {
+				runningSum.add(&buckets[l])
+			}
+			totals[k].add(&runningSum)
+		}
 	}
-
-	chRes <- total
 }
 
 // we declare the buckets as fixed-size array types
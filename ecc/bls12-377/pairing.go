@@ -8,6 +8,7 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
+	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 // GT target group of the pairing
@@ -34,6 +35,10 @@ func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
 // PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
 // ∏ᵢ e(Pᵢ, Qᵢ) =? 1
 //
+// An equality e(A, B) =? e(C, D) is checked as e(A, B)⋅e(C, -D) =? 1, that is
+// PairingCheck([]G1Affine{A, C}, []G2Affine{B, PrecomputeNegated(D)}). When D
+// is fixed, -D should be computed once and reused across checks.
+//
 // This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
 func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
 	f, err := Pair(P, Q)
@@ -45,6 +50,14 @@ func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
 	return f.Equal(&one), nil
 }
 
+// PrecomputeNegated returns -Q, to be passed to PairingCheck (or to PrecomputeLines
+// when Q is fixed) in place of Q when checking e(A, B) =? e(C, Q).
+func PrecomputeNegated(Q G2Affine) G2Affine {
+	var negQ G2Affine
+	negQ.Neg(&Q)
+	return negQ
+}
+
 // FinalExponentiation computes the exponentiation (∏ᵢ zᵢ)ᵈ
 // where d = (p¹²-1)/r = (p¹²-1)/Φ₁₂(p) ⋅ Φ₁₂(p)/r = (p⁶-1)(p²+1)(p⁴ - p² +1)/r
 // we use instead d=s ⋅ (p⁶-1)(p²+1)(p⁴ - p² +1)/r
@@ -99,8 +112,54 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 	return result
 }
 
+// FinalExponentiationUnsafe computes the final exponentiation xᵈ of a single
+// element, without the product of FinalExponentiation, and returns it in a new GT.
+//
+// It assumes x is the output of a Miller loop (e.g. computed externally from
+// accumulated line evaluations) and in particular that x ≠ 0; no check is
+// performed and the result is meaningless otherwise.
+func FinalExponentiationUnsafe(x *GT) *GT {
+	res := FinalExponentiation(x)
+	return &res
+}
+
+// BatchFinalExponentiation computes the final exponentiation of each element
+// independently, such that res[i] = FinalExponentiation(&elements[i]).
+//
+// The elements are distributed across runtime.NumCPU() goroutines.
+func BatchFinalExponentiation(elements []GT) []GT {
+	res := make([]GT, len(elements))
+	parallel.Execute(len(elements), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i] = FinalExponentiation(&elements[i])
+		}
+	})
+	return res
+}
+
+// MillerLoopChecked is MillerLoop, but it first checks that the points of P
+// are in G1 and the points of Q in G2, and returns an error otherwise.
+func MillerLoopChecked(P []G1Affine, Q []G2Affine) (GT, error) {
+	if len(P) != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+	for i := range P {
+		if !P[i].IsInSubGroup() {
+			return GT{}, errors.New("a point of P is not in G1")
+		}
+		if !Q[i].IsInSubGroup() {
+			return GT{}, errors.New("a point of Q is not in G2")
+		}
+	}
+	return MillerLoop(P, Q)
+}
+
 // MillerLoop computes the multi-Miller loop
 // ∏ᵢ MillerLoop(Pᵢ, Qᵢ) = ∏ᵢ { fᵢ_{x,Qᵢ}(Pᵢ) }
+//
+// The result is not reduced: several Miller loops can be multiplied together,
+// or passed to FinalExponentiation at once, before a single final exponentiation.
+// This function doesn't check that the inputs are in the correct subgroup. See MillerLoopChecked.
 func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	// check input size match
 	n := len(P)
@@ -234,6 +293,99 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	return result, nil
 }
 
+// MillerLoopWithCount computes the multi-Miller loop
+// ∏ᵢ { fᵢ_{c,Qᵢ}(Pᵢ) }
+// for a custom loop count c, given as its signed binary (NAF) digits in
+// little-endian order, i.e. c = ∑ⱼ loopCount[j]·2ʲ with loopCount[j] ∈ {-1, 0, 1}.
+// The most significant digit must be 1.
+//
+// With loopCount = LoopCounter[:], the output is the same as MillerLoop. This
+// is meant for experimenting with pairing variants: for other loop counts, the
+// output is not guaranteed to give a bilinear pairing after the final
+// exponentiation.
+func MillerLoopWithCount(P []G1Affine, Q []G2Affine, loopCount []int8) (GT, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// check the loop count
+	if len(loopCount) == 0 || loopCount[len(loopCount)-1] != 1 {
+		return GT{}, errors.New("the most significant digit of the loop count must be 1")
+	}
+	for _, d := range loopCount {
+		if d < -1 || d > 1 {
+			return GT{}, errors.New("the digits of the loop count must be in {-1, 0, 1}")
+		}
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	q := make([]G2Affine, 0, n)
+
+	for k := 0; k < n; k++ {
+		if P[k].IsInfinity() || Q[k].IsInfinity() {
+			continue
+		}
+		p = append(p, P[k])
+		q = append(q, Q[k])
+	}
+
+	n = len(p)
+
+	// projective points for Q, and -Q for the negative digits
+	qProj := make([]g2Proj, n)
+	qNeg := make([]G2Affine, n)
+	for k := 0; k < n; k++ {
+		qProj[k].FromAffine(&q[k])
+		qNeg[k].Neg(&q[k])
+	}
+
+	var result GT
+	result.SetOne()
+	var l1, l2 lineEvaluation
+	var prodLines [5]E2
+
+	for i := len(loopCount) - 2; i >= 0; i-- {
+		// mutualize the square among n Miller loops
+		// (∏ᵢfᵢ)²
+		result.Square(&result)
+
+		for k := 0; k < n; k++ {
+			// qProj[k] ← 2qProj[k] and l1 the tangent ℓ passing 2qProj[k]
+			qProj[k].doubleStep(&l1)
+			// line evaluation at P[k]
+			l1.r0.MulByElement(&l1.r0, &p[k].Y)
+			l1.r1.MulByElement(&l1.r1, &p[k].X)
+
+			switch loopCount[i] {
+			case 0:
+				// ℓ × res
+				result.MulBy034(&l1.r0, &l1.r1, &l1.r2)
+				continue
+			case 1:
+				// qProj[k] ← qProj[k]+Q[k] and
+				// l2 the line ℓ passing qProj[k] and Q[k]
+				qProj[k].addMixedStep(&l2, &q[k])
+			case -1:
+				// qProj[k] ← qProj[k]-Q[k] and
+				// l2 the line ℓ passing qProj[k] and -Q[k]
+				qProj[k].addMixedStep(&l2, &qNeg[k])
+			}
+			// line evaluation at P[k]
+			l2.r0.MulByElement(&l2.r0, &p[k].Y)
+			l2.r1.MulByElement(&l2.r1, &p[k].X)
+			// ℓ × ℓ
+			prodLines = fptower.Mul034By034(&l1.r0, &l1.r1, &l1.r2, &l2.r0, &l2.r1, &l2.r2)
+			// (ℓ × ℓ) × res
+			result.MulBy01234(&prodLines)
+		}
+	}
+
+	return result, nil
+}
+
 // doubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
 func (p *g2Proj) doubleStep(evaluations *lineEvaluation) {
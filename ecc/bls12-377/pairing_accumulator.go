@@ -0,0 +1,44 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+// PairingAccumulator buffers pairs (Pᵢ, Qᵢ) as they are added, to compute
+// ∏ᵢ e(Pᵢ, Qᵢ) with a single multi-Miller loop and final exponentiation.
+// The zero value is an empty accumulator ready to use.
+//
+// As for Pair, the points are not checked to be in the correct subgroup. See
+// IsInSubGroup.
+type PairingAccumulator struct {
+	p []G1Affine
+	q []G2Affine
+}
+
+// AddPair adds the pair (P, Q) to the product.
+func (acc *PairingAccumulator) AddPair(P G1Affine, Q G2Affine) {
+	acc.p = append(acc.p, P)
+	acc.q = append(acc.q, Q)
+}
+
+// Len returns the number of pairs added since the last Reset.
+func (acc *PairingAccumulator) Len() int {
+	return len(acc.p)
+}
+
+// Result returns the reduced pairing ∏ᵢ e(Pᵢ, Qᵢ) of the added pairs, as Pair
+// does. It returns an error if no pair was added.
+func (acc *PairingAccumulator) Result() (GT, error) {
+	return Pair(acc.p, acc.q)
+}
+
+// Check returns true if the product ∏ᵢ e(Pᵢ, Qᵢ) of the added pairs is one, as
+// PairingCheck does. It returns an error if no pair was added.
+func (acc *PairingAccumulator) Check() (bool, error) {
+	return PairingCheck(acc.p, acc.q)
+}
+
+// Reset removes all the pairs, keeping the allocated buffers.
+func (acc *PairingAccumulator) Reset() {
+	acc.p = acc.p[:0]
+	acc.q = acc.q[:0]
+}
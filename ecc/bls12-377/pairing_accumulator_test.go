@@ -0,0 +1,63 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairingAccumulator(t *testing.T) {
+	assert := require.New(t)
+
+	var acc PairingAccumulator
+	_, err := acc.Result()
+	assert.Error(err, "empty accumulator")
+
+	const n = 4
+	P := make([]G1Affine, n)
+	Q := make([]G2Affine, n)
+	var s fr.Element
+	var sInt big.Int
+	for i := range P {
+		s.MustSetRandom().BigInt(&sInt)
+		P[i].ScalarMultiplication(&g1GenAff, &sInt)
+		s.MustSetRandom().BigInt(&sInt)
+		Q[i].ScalarMultiplication(&g2GenAff, &sInt)
+	}
+	// pairs with a point at infinity are skipped by Pair
+	P[2].SetInfinity()
+
+	for i := range P {
+		acc.AddPair(P[i], Q[i])
+		assert.Equal(i+1, acc.Len())
+
+		res, err := acc.Result()
+		assert.NoError(err)
+		expected, err := Pair(P[:i+1], Q[:i+1])
+		assert.NoError(err)
+		assert.True(res.Equal(&expected), "accumulated pairing after %d pairs", i+1)
+	}
+
+	ok, err := acc.Check()
+	assert.NoError(err)
+	assert.False(ok)
+
+	// e(a⋅g₁, g₂)⋅e(g₁, -a⋅g₂) = 1
+	acc.Reset()
+	assert.Equal(0, acc.Len())
+	var aG1 G1Affine
+	var aG2 G2Affine
+	s.MustSetRandom().BigInt(&sInt)
+	aG1.ScalarMultiplication(&g1GenAff, &sInt)
+	aG2.ScalarMultiplication(&g2GenAff, &sInt).Neg(&aG2)
+	acc.AddPair(aG1, g2GenAff)
+	acc.AddPair(g1GenAff, aG2)
+	ok, err = acc.Check()
+	assert.NoError(err)
+	assert.True(ok)
+}
@@ -0,0 +1,25 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// BindFr binds the challenge of the transcript to the canonical encoding of e
+// (see fr.Element.Bytes: big-endian, in regular form), such that every caller
+// hashes the same bytes for the same element.
+func BindFr(t *fiatshamir.Transcript, challengeID string, e fr.Element) error {
+	b := e.Bytes()
+	return t.Bind(challengeID, b[:])
+}
+
+// BindG1 binds the challenge of the transcript to the canonical encoding of p
+// (see G1Affine.RawBytes: uncompressed), such that every caller hashes the same
+// bytes for the same point.
+func BindG1(t *fiatshamir.Transcript, challengeID string, p G1Affine) error {
+	b := p.RawBytes()
+	return t.Bind(challengeID, b[:])
+}
@@ -0,0 +1,71 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls12377
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+func TestBindFrG1(t *testing.T) {
+	t.Parallel()
+
+	var e fr.Element
+	e.MustSetRandom()
+	var p G1Affine
+	var s big.Int
+	p.ScalarMultiplication(&g1GenAff, e.BigInt(&s))
+
+	typed := fiatshamir.NewTranscript(sha256.New(), "alpha")
+	if err := BindFr(typed, "alpha", e); err != nil {
+		t.Fatal(err)
+	}
+	if err := BindG1(typed, "alpha", p); err != nil {
+		t.Fatal(err)
+	}
+
+	// canonical encodings
+	eBytes := e.Bytes()
+	pBytes := p.RawBytes()
+	generic := fiatshamir.NewTranscript(sha256.New(), "alpha")
+	if err := generic.Bind("alpha", eBytes[:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := generic.Bind("alpha", pBytes[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	typedChallenge, err := typed.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	genericChallenge, err := generic.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(typedChallenge, genericChallenge) {
+		t.Fatal("binding an element should be the same as binding its canonical bytes")
+	}
+
+	// the typed binds and BindElement hash the same bytes
+	viaMarshal := fiatshamir.NewTranscript(sha256.New(), "alpha")
+	if err := viaMarshal.BindElement("alpha", &e); err != nil {
+		t.Fatal(err)
+	}
+	if err := viaMarshal.BindElement("alpha", &p); err != nil {
+		t.Fatal(err)
+	}
+	marshalChallenge, err := viaMarshal.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(typedChallenge, marshalChallenge) {
+		t.Fatal("BindFr and BindG1 should match BindElement")
+	}
+}
@@ -6,6 +6,7 @@
 package twistededwards
 
 import (
+	"encoding/binary"
 	"math/big"
 	"sync"
 
@@ -50,6 +51,59 @@ func initCurveParams() {
 	curveParams.Base.Y.SetString("882565546457454111605105352482086902132191855952243170543452705048019814192")
 }
 
+// basePointDST is the domain separation tag used to hash to the y-coordinate
+// candidates in GenerateBasePoint.
+const basePointDST = "gnark-crypto/ecc/bls12-377/twistededwards base point"
+
+// GenerateBasePoint derives a point of the prime order subgroup from a fixed
+// string, so that it can be reproduced by anyone. For i = 0, 1, ... it hashes
+// the big-endian uint32 i to y with fr.Hash and basePointDST, until (x, y) is
+// on the curve (x being the lexicographically smallest root) and its multiple
+// by the cofactor is not the identity, which is then returned.
+//
+// Note that the result differs from the Base of GetEdwardsCurve, which was not
+// derived this way. Both generate the same prime order subgroup.
+func GenerateBasePoint() PointAffine {
+	initOnce.Do(initCurveParams)
+
+	var cofactor big.Int
+	curveParams.Cofactor.BigInt(&cofactor)
+
+	var one, num, den fr.Element
+	var msg [4]byte
+	one.SetOne()
+	for i := uint32(0); ; i++ {
+		binary.BigEndian.PutUint32(msg[:], i)
+		y, err := fr.Hash(msg[:], []byte(basePointDST), 1)
+		if err != nil {
+			panic(err)
+		}
+
+		// x² = (1 - y²) / (a - d⋅y²)
+		var p PointAffine
+		p.Y = y[0]
+		num.Square(&p.Y)
+		den.Mul(&num, &curveParams.D)
+		num.Sub(&one, &num)
+		den.Sub(&curveParams.A, &den)
+		if den.IsZero() {
+			continue
+		}
+		p.X.Div(&num, &den)
+		if p.X.Sqrt(&p.X) == nil {
+			continue
+		}
+		if p.X.LexicographicallyLargest() {
+			p.X.Neg(&p.X)
+		}
+
+		p.ScalarMultiplication(&p, &cofactor)
+		if !p.IsZero() {
+			return p
+		}
+	}
+}
+
 // mulByA multiplies fr.Element by curveParams.A
 func mulByA(x *fr.Element) {
 	x.Neg(x)
@@ -0,0 +1,156 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"bytes"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestFixedBaseTable(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	params := GetEdwardsCurve()
+	tables := []*FixedBaseTable{
+		NewFixedBaseTable(params.Base, 1),
+		NewFixedBaseTable(params.Base, 5),
+	}
+
+	properties.Property("FixedBaseTable.ScalarMul should match ScalarMultiplication", prop.ForAll(
+		func(s big.Int) bool {
+			var expected PointAffine
+			expected.ScalarMultiplication(&params.Base, &s)
+			for _, table := range tables {
+				p := table.ScalarMul(&s)
+				if !p.Equal(&expected) {
+					return false
+				}
+			}
+			return true
+		},
+		GenBigInt(),
+	))
+
+	properties.Property("FixedBaseTable.ScalarMul should handle negative scalars", prop.ForAll(
+		func(s big.Int) bool {
+			var expected PointAffine
+			expected.ScalarMultiplication(&params.Base, &s).Neg(&expected)
+			s.Neg(&s)
+			p := tables[1].ScalarMul(&s)
+			return p.Equal(&expected)
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var infinity PointAffine
+	infinity.setInfinity()
+	for _, s := range []*big.Int{big.NewInt(0), &params.Order} {
+		p := tables[1].ScalarMul(s)
+		if !p.Equal(&infinity) {
+			t.Fatal("[0]base and [order]base should be the point at infinity")
+		}
+	}
+	p := tables[1].ScalarMul(big.NewInt(1))
+	if !p.Equal(&params.Base) {
+		t.Fatal("[1]base should be base")
+	}
+}
+
+func TestFixedBaseTableSerialization(t *testing.T) {
+	t.Parallel()
+
+	params := GetEdwardsCurve()
+	table := NewFixedBaseTable(params.Base, 4)
+
+	var buf bytes.Buffer
+	written, err := table.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	var decoded FixedBaseTable
+	read, err := decoded.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read != written {
+		t.Fatal("read and written sizes differ")
+	}
+	base := decoded.Base()
+	if !base.Equal(&params.Base) {
+		t.Fatal("base point mismatch")
+	}
+	s := big.NewInt(0xdeadbeef)
+	p1, p2 := table.ScalarMul(s), decoded.ScalarMul(s)
+	if !p1.Equal(&p2) {
+		t.Fatal("decoded table doesn't match")
+	}
+
+	// truncated stream
+	if _, err = new(FixedBaseTable).ReadFrom(bytes.NewReader(data[:len(data)-1])); err == nil {
+		t.Fatal("expected an error on a truncated stream")
+	}
+
+	// corrupted point
+	corrupted := make([]byte, len(data))
+	copy(corrupted, data)
+	corrupted[len(corrupted)-1] ^= 1
+	if _, err = new(FixedBaseTable).ReadFrom(bytes.NewReader(corrupted)); err == nil {
+		t.Fatal("expected an error on a corrupted table")
+	}
+
+	// on-curve entries which are not the right multiples of the base point:
+	// replace an entry by the previous one
+	const nbDigits = 1<<4 - 1
+	pointSize := 2 * len(params.Base.X.Bytes())
+	nbEntries := len(decoded.table) * nbDigits
+	for _, entry := range []int{1, nbDigits - 1, nbDigits, nbDigits + 3, nbEntries - 1} {
+		tampered := make([]byte, len(data))
+		copy(tampered, data)
+		offset := 8 + sizePointCompressed + entry*pointSize
+		copy(tampered[offset:offset+pointSize], data[offset-pointSize:offset])
+		if _, err = new(FixedBaseTable).ReadFrom(bytes.NewReader(tampered)); err == nil {
+			t.Fatalf("expected an error on a table with a wrong entry %d", entry)
+		}
+	}
+}
+
+func BenchmarkFixedBaseTable(b *testing.B) {
+	params := GetEdwardsCurve()
+	var s big.Int
+	s.SetString("52435875175126190479447740508185965837690552500527637822603658699938581184511", 10)
+
+	b.Run("ScalarMultiplication", func(b *testing.B) {
+		var p PointAffine
+		for i := 0; i < b.N; i++ {
+			p.ScalarMultiplication(&params.Base, &s)
+		}
+	})
+	for _, windowBits := range []int{4, 8} {
+		table := NewFixedBaseTable(params.Base, windowBits)
+		b.Run("FixedBaseTable/w="+strconv.Itoa(windowBits), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				table.ScalarMul(&s)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// ErrExceptionalPoint is returned by FromMontgomery for the points of the
+// Montgomery curve which have no affine twisted Edwards image, that is the
+// points with v = 0 other than (0, 0) and the points with u = -1.
+var ErrExceptionalPoint = errors.New("exceptional point of the birational map")
+
+var errNotOnMontgomeryCurve = errors.New("point not on the Montgomery curve")
+
+// MontgomeryPoint is a point (u, v) on the Montgomery curve
+// B⋅v² = u³ + A⋅u² + u, with A = 2(a+d)/(a-d) and B = 4/(a-d),
+// birationally equivalent to the twisted Edwards curve a⋅x² + y² = 1 + d⋅x²⋅y².
+// The point at infinity is represented with Infinity set.
+type MontgomeryPoint struct {
+	U, V     fr.Element
+	Infinity bool
+}
+
+// MontgomeryCoefficients returns the coefficients A and B of the Montgomery
+// curve B⋅v² = u³ + A⋅u² + u birationally equivalent to the twisted Edwards curve.
+func MontgomeryCoefficients() (A, B fr.Element) {
+	initOnce.Do(initCurveParams)
+
+	var aMinusD fr.Element
+	aMinusD.Sub(&curveParams.A, &curveParams.D).Inverse(&aMinusD)
+	A.Add(&curveParams.A, &curveParams.D).Double(&A).Mul(&A, &aMinusD)
+	B.SetUint64(4).Mul(&B, &aMinusD)
+	return
+}
+
+// IsOnCurve checks if a point is on the Montgomery curve
+func (p *MontgomeryPoint) IsOnCurve() bool {
+	if p.Infinity {
+		return true
+	}
+	A, B := MontgomeryCoefficients()
+	var one fr.Element
+	one.SetOne()
+
+	// B⋅v² = u⋅(u⋅(u + A) + 1)
+	var lhs, rhs fr.Element
+	lhs.Square(&p.V).Mul(&lhs, &B)
+	rhs.Add(&p.U, &A).Mul(&rhs, &p.U)
+	rhs.Add(&rhs, &one).Mul(&rhs, &p.U)
+
+	return lhs.Equal(&rhs)
+}
+
+// ToMontgomery maps p to the Montgomery curve with
+// (u, v) = ((1+y)/(1-y), (1+y)/((1-y)⋅x)).
+// The exceptional points of the map are sent to the corresponding special
+// points: the identity (0, 1) to the point at infinity, and the point of order
+// two (0, -1) to (0, 0).
+func ToMontgomery(p PointAffine) (MontgomeryPoint, error) {
+	var res MontgomeryPoint
+	if !p.IsOnCurve() {
+		return res, errNotOnCurve
+	}
+	// on the curve, x = 0 iff y = ±1
+	if p.X.IsZero() {
+		res.Infinity = p.Y.IsOne()
+		return res, nil
+	}
+
+	var one, den fr.Element
+	one.SetOne()
+	res.U.Add(&one, &p.Y)
+	den.Sub(&one, &p.Y)
+	res.V.Mul(&den, &p.X)
+	// batch the two inversions: 1/(1-y) = x/((1-y)⋅x) and 1/((1-y)⋅x)
+	res.V.Inverse(&res.V)
+	den.Mul(&res.V, &p.X)
+	res.V.Mul(&res.V, &res.U)
+	res.U.Mul(&res.U, &den)
+
+	return res, nil
+}
+
+// FromMontgomery maps p to the twisted Edwards curve with
+// (x, y) = (u/v, (u-1)/(u+1)). It is the inverse of ToMontgomery: the point at
+// infinity is sent to the identity (0, 1) and (0, 0) to (0, -1). It returns
+// ErrExceptionalPoint for the other points with v = 0 or u = -1.
+func FromMontgomery(p MontgomeryPoint) (PointAffine, error) {
+	var res PointAffine
+	if !p.IsOnCurve() {
+		return res, errNotOnMontgomeryCurve
+	}
+	if p.Infinity {
+		res.Y.SetOne()
+		return res, nil
+	}
+	if p.U.IsZero() {
+		// then v = 0
+		res.Y.SetOne().Neg(&res.Y)
+		return res, nil
+	}
+
+	var one, uPlusOne fr.Element
+	one.SetOne()
+	uPlusOne.Add(&p.U, &one)
+	if p.V.IsZero() || uPlusOne.IsZero() {
+		return res, ErrExceptionalPoint
+	}
+
+	// batch the two inversions
+	var inv fr.Element
+	inv.Mul(&p.V, &uPlusOne).Inverse(&inv)
+	res.X.Mul(&inv, &uPlusOne).Mul(&res.X, &p.U)
+	res.Y.Sub(&p.U, &one).Mul(&res.Y, &inv).Mul(&res.Y, &p.V)
+
+	return res, nil
+}
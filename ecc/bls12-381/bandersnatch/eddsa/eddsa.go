@@ -21,6 +21,14 @@ import (
 var errNotOnCurve = errors.New("point not on curve")
 var errHashNeeded = errors.New("hFunc cannot be nil. We need a hash for Fiat-Shamir")
 
+// ErrContextTooLong is returned when signing or verifying with a context longer
+// than 255 bytes.
+var ErrContextTooLong = errors.New("context must be at most 255 bytes")
+
+// contextDST is the domain separation tag used to hash the context into the
+// field, see [PrivateKey.SignWithContext].
+const contextDST = "gnark-crypto EdDSA with context"
+
 const (
 	sizeFr         = fr.Bytes
 	sizePublicKey  = sizeFr
@@ -108,6 +116,63 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 // For arbitrary strings use fr.Hash first
 // Pure Eddsa version (see https://tools.ietf.org/html/rfc8032#page-8)
 func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	return privKey.sign(nil, message, hFunc, nil)
+}
+
+// SignWithContext is like [PrivateKey.Sign], but binds the signature to a
+// context of at most 255 bytes, as in Ed25519ctx (see
+// https://tools.ietf.org/html/rfc8032#section-5.1). The challenge is
+// H(dom, R, A, M) with dom = tag || fr.Hash(context, contextDST, 1)[0], and dom
+// is also prepended to the nonce derivation. The signature must be verified with
+// [PublicKey.VerifyWithContext] and the same context.
+//
+// dom is made of two canonical field elements, tag being fr.Hash(contextDST,
+// contextDST, 1)[0], so that hFunc may be MiMC as well as SHA-256.
+func (privKey *PrivateKey) SignWithContext(message, context []byte, hFunc hash.Hash) ([]byte, error) {
+	dom, err := contextPrefix(context)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.sign(dom, message, hFunc, nil)
+}
+
+// contextPrefix returns dom = tag || fr.Hash(context, contextDST, 1)[0], as
+// canonical field elements
+func contextPrefix(context []byte) ([]byte, error) {
+	if len(context) > 255 {
+		return nil, ErrContextTooLong
+	}
+	tag, err := fr.Hash([]byte(contextDST), []byte(contextDST), 1)
+	if err != nil {
+		return nil, err
+	}
+	h, err := fr.Hash(context, []byte(contextDST), 1)
+	if err != nil {
+		return nil, err
+	}
+	bTag := tag[0].Bytes()
+	bH := h[0].Bytes()
+	return append(bTag[:], bH[:]...), nil
+}
+
+// SignWithTable is like [PrivateKey.Sign], but computes the commitment R using
+// a precomputed table of multiples of the curve base point, see
+// [twistededwards.NewFixedBaseTable]. The table should be built once and
+// reused across signatures. It returns the same signature as Sign.
+func (privKey *PrivateKey) SignWithTable(message []byte, hFunc hash.Hash, table *twistededwards.FixedBaseTable) ([]byte, error) {
+	if table == nil {
+		return nil, errors.New("table cannot be nil")
+	}
+	curveParams := twistededwards.GetEdwardsCurve()
+	if base := table.Base(); !base.Equal(&curveParams.Base) {
+		return nil, errors.New("table base point is not the curve base point")
+	}
+	return privKey.sign(nil, message, hFunc, table)
+}
+
+// sign computes the signature of message, dom being the (possibly empty)
+// domain separation prefix of the hashes.
+func (privKey *PrivateKey) sign(dom, message []byte, hFunc hash.Hash, table *twistededwards.FixedBaseTable) ([]byte, error) {
 
 	// hFunc cannot be nil.
 	// We need a hash function for the Fiat-Shamir.
@@ -121,32 +186,37 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 
 	// blinding factor for the private key
 	// blindingFactorBigInt must be the same size as the private key,
-	// blindingFactorBigInt = h(randomness_source||message)[:sizeFr]
+	// blindingFactorBigInt = h(dom||randomness_source||message)[:sizeFr]
 	var blindingFactorBigInt big.Int
 
-	// randSrc = privKey.randSrc || msg (-> message = MSB message .. LSB message)
-	randSrc := make([]byte, 32+len(message))
-	copy(randSrc, privKey.randSrc[:])
-	copy(randSrc[32:], message)
+	// randSrc = dom || privKey.randSrc || msg (-> message = MSB message .. LSB message)
+	randSrc := make([]byte, len(dom)+32+len(message))
+	copy(randSrc, dom)
+	copy(randSrc[len(dom):], privKey.randSrc[:])
+	copy(randSrc[len(dom)+32:], message)
 
 	// randBytes = H(randSrc)
 	blindingFactorBytes := blake2b.Sum512(randSrc[:]) // TODO ensures that the hash used to build the key and the one used here is the same
 	blindingFactorBigInt.SetBytes(blindingFactorBytes[:sizeFr])
 
 	// compute R = randScalar*Base
-	res.R.ScalarMultiplication(&curveParams.Base, &blindingFactorBigInt)
+	if table != nil {
+		res.R = table.ScalarMul(&blindingFactorBigInt)
+	} else {
+		res.R.ScalarMultiplication(&curveParams.Base, &blindingFactorBigInt)
+	}
 	if !res.R.IsOnCurve() {
 		return nil, errNotOnCurve
 	}
 
-	// compute H(R, A, M), all parameters in data are in Montgomery form
+	// compute H(dom, R, A, M), all parameters in data are in Montgomery form
 	hFunc.Reset()
 
 	resRX := res.R.X.Bytes()
 	resRY := res.R.Y.Bytes()
 	resAX := privKey.PublicKey.A.X.Bytes()
 	resAY := privKey.PublicKey.A.Y.Bytes()
-	toWrite := [][]byte{resRX[:], resRY[:], resAX[:], resAY[:], message}
+	toWrite := [][]byte{dom, resRX[:], resRY[:], resAX[:], resAY[:], message}
 	for _, bytes := range toWrite {
 		if _, err := hFunc.Write(bytes); err != nil {
 			return nil, err
@@ -176,6 +246,20 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 
 // Verify verifies an eddsa signature
 func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	return pub.verify(sigBin, nil, message, hFunc)
+}
+
+// VerifyWithContext verifies an eddsa signature computed with
+// [PrivateKey.SignWithContext] and the same context.
+func (pub *PublicKey) VerifyWithContext(sigBin, message, context []byte, hFunc hash.Hash) (bool, error) {
+	dom, err := contextPrefix(context)
+	if err != nil {
+		return false, err
+	}
+	return pub.verify(sigBin, dom, message, hFunc)
+}
+
+func (pub *PublicKey) verify(sigBin, dom, message []byte, hFunc hash.Hash) (bool, error) {
 
 	// hFunc cannot be nil.
 	// We need a hash function for the Fiat-Shamir.
@@ -196,7 +280,7 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 		return false, err
 	}
 
-	// compute H(R, A, M), all parameters in data are in Montgomery form
+	// compute H(dom, R, A, M), all parameters in data are in Montgomery form
 
 	hFunc.Reset()
 
@@ -205,7 +289,7 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 	sigAX := pub.A.X.Bytes()
 	sigAY := pub.A.Y.Bytes()
 
-	toWrite := [][]byte{sigRX[:], sigRY[:], sigAX[:], sigAY[:], message}
+	toWrite := [][]byte{dom, sigRX[:], sigRY[:], sigAX[:], sigAY[:], message}
 	for _, bytes := range toWrite {
 		if _, err := hFunc.Write(bytes); err != nil {
 			return false, err
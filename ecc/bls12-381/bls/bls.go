@@ -0,0 +1,163 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package bls implements the BLS signature scheme of draft-irtf-cfrg-bls-signature
+// over BLS12-381, in the minimal-pubkey-size variant: public keys are in G1 and
+// signatures in G2.
+//
+// The basic scheme (Sign, Verify) uses the ciphersuite
+// BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_. The proof of possession scheme
+// (PopProve, PopVerify, PopSign, FastAggregateVerify) uses
+// BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_, and allows to verify signatures
+// of the same message by many signers at the cost of a single verification.
+// Keys and signatures are serialized in the compressed format also used by
+// other BLS12-381 libraries (48 bytes for public keys, 96 bytes for signatures).
+//
+// Deriving the public key and signing use the constant-time scalar
+// multiplications of G1 and G2 (ScalarMultiplicationConstantTime).
+//
+// See https://datatracker.ietf.org/doc/draft-irtf-cfrg-bls-signature/
+package bls
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// DST is the domain separation tag of the basic scheme ciphersuite, with which
+// messages are hashed to G2.
+const DST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"
+
+const (
+	SizeSecretKey = fr.Bytes
+	SizePublicKey = bls12381.SizeOfG1AffineCompressed
+	SizeSignature = bls12381.SizeOfG2AffineCompressed
+
+	// keyGenSalt is the initial salt of KeyGen
+	keyGenSalt = "BLS-SIG-KEYGEN-SALT-"
+	// keyGenL = ceil((3 * ceil(log2(r))) / 16) is the number of bytes reduced
+	// modulo r into a secret key
+	keyGenL = 48
+	// minIKMSize is the minimum size of the input keying material of KeyGen
+	minIKMSize = 32
+)
+
+var (
+	ErrShortIKM         = errors.New("input keying material must be at least 32 bytes")
+	ErrInvalidSecretKey = errors.New("invalid secret key")
+	ErrInvalidPublicKey = errors.New("invalid public key")
+)
+
+// SecretKey is a BLS secret key, a non-zero scalar modulo r.
+type SecretKey struct {
+	scalar fr.Element
+}
+
+// PublicKey is a BLS public key sk⋅g₁.
+type PublicKey struct {
+	A bls12381.G1Affine
+}
+
+// Signature is a BLS signature sk⋅H(msg), H hashing to G2.
+type Signature struct {
+	S bls12381.G2Affine
+}
+
+// KeyGen derives a key pair from the input keying material ikm, which must be
+// at least 32 bytes long and must be kept secret. It is deterministic: the same
+// ikm always gives the same keys.
+//
+// The secret key is derived with HKDF-SHA256 as in the KeyGen procedure of the
+// draft (with an empty key_info), which is also the derivation of EIP-2333.
+func KeyGen(ikm []byte) (SecretKey, PublicKey, error) {
+	if len(ikm) < minIKMSize {
+		return SecretKey{}, PublicKey{}, ErrShortIKM
+	}
+
+	// salt = H(salt)
+	// PRK = HKDF-Extract(salt, IKM ∥ I2OSP(0, 1))
+	// OKM = HKDF-Expand(PRK, key_info ∥ I2OSP(L, 2), L)
+	// SK = OS2IP(OKM) mod r, until SK ≠ 0
+	secret := make([]byte, len(ikm)+1)
+	copy(secret, ikm)
+	info := string([]byte{0, keyGenL})
+	salt := []byte(keyGenSalt)
+	var sk SecretKey
+	var v big.Int
+	for sk.scalar.IsZero() {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		prk, err := hkdf.Extract(sha256.New, secret, salt)
+		if err != nil {
+			return SecretKey{}, PublicKey{}, err
+		}
+		okm, err := hkdf.Expand(sha256.New, prk, info, keyGenL)
+		if err != nil {
+			return SecretKey{}, PublicKey{}, err
+		}
+		sk.scalar.SetBigInt(v.SetBytes(okm))
+	}
+
+	return sk, sk.Public(), nil
+}
+
+// Public returns the public key sk⋅g₁ associated to sk.
+func (sk *SecretKey) Public() PublicKey {
+	_, _, g1, _ := bls12381.Generators()
+	var s big.Int
+	sk.scalar.BigInt(&s)
+	var pk PublicKey
+	pk.A.ScalarMultiplicationConstantTime(&g1, &s)
+	return pk
+}
+
+// Sign returns the signature sk⋅H(msg) of msg, H being HashToG2 with DST.
+func Sign(sk SecretKey, msg []byte) (Signature, error) {
+	return sign(sk, msg, []byte(DST))
+}
+
+func sign(sk SecretKey, msg, dst []byte) (Signature, error) {
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return Signature{}, err
+	}
+	var s big.Int
+	sk.scalar.BigInt(&s)
+	var sig Signature
+	sig.S.ScalarMultiplicationConstantTime(&h, &s)
+	return sig, nil
+}
+
+// Verify returns true if sig is a valid signature of msg under pk, that is if
+// e(pk, H(msg)) = e(g₁, sig). Public keys and signatures which are the point
+// at infinity or not in the prime order subgroups are rejected.
+func Verify(pk PublicKey, msg []byte, sig Signature) bool {
+	return verify(pk, msg, sig, []byte(DST))
+}
+
+func verify(pk PublicKey, msg []byte, sig Signature, dst []byte) bool {
+	if !pk.isValid() || !sig.S.IsInSubGroup() {
+		return false
+	}
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return false
+	}
+
+	// e(pk, H(msg)) ⋅ e(-g₁, sig) =? 1
+	_, _, g1, _ := bls12381.Generators()
+	g1.Neg(&g1)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{pk.A, g1}, []bls12381.G2Affine{h, sig.S})
+	return err == nil && ok
+}
+
+// isValid is KeyValidate of the draft: the key is not the point at infinity
+// and is in the prime order subgroup.
+func (pk *PublicKey) isValid() bool {
+	return !pk.A.IsInfinity() && pk.A.IsInSubGroup()
+}
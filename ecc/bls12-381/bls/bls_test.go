@@ -0,0 +1,131 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors of EIP-2333 for the derivation of the master secret key, which
+// is KeyGen with an empty key_info.
+func TestKeyGenVectors(t *testing.T) {
+	assert := require.New(t)
+
+	vectors := []struct {
+		ikm string
+		sk  string
+	}{
+		{
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			"6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		},
+		{
+			"3141592653589793238462643383279502884197169399375105820974944592",
+			"29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		},
+		{
+			"0099FF991111002299DD7744EE3355BBDD8844115566CC55663355668888CC00",
+			"27580842291869792442942448775674722299803720648445448686099262467207037398656",
+		},
+		{
+			"d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+			"19022158461524446591288038168518313374041767046816487870552872741050760015818",
+		},
+	}
+
+	for _, v := range vectors {
+		ikm, err := hex.DecodeString(v.ikm)
+		assert.NoError(err)
+		sk, pk, err := KeyGen(ikm)
+		assert.NoError(err)
+
+		var s big.Int
+		sk.scalar.BigInt(&s)
+		assert.Equal(v.sk, s.String())
+		expected := sk.Public()
+		assert.True(pk.A.Equal(&expected.A))
+	}
+
+	_, _, err := KeyGen(make([]byte, 31))
+	assert.ErrorIs(err, ErrShortIKM)
+}
+
+// Test vector of the Ethereum consensus specifications, which use the proof of
+// possession ciphersuite: only the DST differs from the basic scheme.
+func TestSignVector(t *testing.T) {
+	assert := require.New(t)
+
+	skBytes, _ := hex.DecodeString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3")
+	pkBytes, _ := hex.DecodeString("a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a")
+	sigBytes, _ := hex.DecodeString("b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55")
+	msg := make([]byte, 32)
+
+	var sk SecretKey
+	_, err := sk.SetBytes(skBytes)
+	assert.NoError(err)
+	pk := sk.Public()
+	assert.Equal(pkBytes, pk.Bytes())
+
+	sig, err := PopSign(sk, msg)
+	assert.NoError(err)
+	assert.Equal(sigBytes, sig.Bytes())
+
+	var decodedPk PublicKey
+	_, err = decodedPk.SetBytes(pkBytes)
+	assert.NoError(err)
+	var decodedSig Signature
+	_, err = decodedSig.SetBytes(sigBytes)
+	assert.NoError(err)
+	assert.True(FastAggregateVerify([]PublicKey{decodedPk}, msg, decodedSig))
+	assert.False(Verify(decodedPk, msg, decodedSig), "signature accepted with another DST")
+}
+
+func TestSignVerify(t *testing.T) {
+	assert := require.New(t)
+
+	sk, pk, err := KeyGen(bytes.Repeat([]byte{0x42}, 32))
+	assert.NoError(err)
+	msg := []byte("gnark-crypto BLS signature")
+	sig, err := Sign(sk, msg)
+	assert.NoError(err)
+	assert.True(Verify(pk, msg, sig))
+
+	// wrong message
+	assert.False(Verify(pk, []byte("another message"), sig))
+
+	// wrong key
+	_, otherPk, err := KeyGen(bytes.Repeat([]byte{0x43}, 32))
+	assert.NoError(err)
+	assert.False(Verify(otherPk, msg, sig))
+
+	// identity key and signature
+	assert.False(Verify(PublicKey{}, msg, Signature{}))
+
+	// serialization
+	var sk2 SecretKey
+	_, err = sk2.SetBytes(sk.Bytes())
+	assert.NoError(err)
+	assert.Equal(sk.Bytes(), sk2.Bytes())
+	var pk2 PublicKey
+	n, err := pk2.SetBytes(pk.Bytes())
+	assert.NoError(err)
+	assert.Equal(SizePublicKey, n)
+	var sig2 Signature
+	n, err = sig2.SetBytes(sig.Bytes())
+	assert.NoError(err)
+	assert.Equal(SizeSignature, n)
+	assert.True(Verify(pk2, msg, sig2))
+
+	// invalid encodings
+	_, err = sk2.SetBytes(make([]byte, SizeSecretKey))
+	assert.ErrorIs(err, ErrInvalidSecretKey)
+	var infinity PublicKey
+	_, err = pk2.SetBytes(infinity.Bytes())
+	assert.ErrorIs(err, ErrInvalidPublicKey)
+}
@@ -0,0 +1,60 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// Bytes returns the big-endian encoding of the secret key, on SizeSecretKey bytes.
+func (sk *SecretKey) Bytes() []byte {
+	b := sk.scalar.Bytes()
+	return b[:]
+}
+
+// SetBytes sets sk from its big-endian encoding, which must be a non-zero
+// scalar smaller than r. It returns the number of bytes read.
+func (sk *SecretKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizeSecretKey {
+		return 0, ErrInvalidSecretKey
+	}
+	var s fr.Element
+	if err := s.SetBytesCanonical(buf[:SizeSecretKey]); err != nil || s.IsZero() {
+		return 0, ErrInvalidSecretKey
+	}
+	sk.scalar = s
+	return SizeSecretKey, nil
+}
+
+// Bytes returns the compressed encoding of the public key, on SizePublicKey bytes.
+func (pk *PublicKey) Bytes() []byte {
+	b := pk.A.Bytes()
+	return b[:]
+}
+
+// SetBytes sets pk from its compressed encoding and returns the number of bytes
+// read. The key must be a point of the prime order subgroup, other than the
+// point at infinity.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	n, err := pk.A.SetBytes(buf)
+	if err != nil {
+		return 0, err
+	}
+	if !pk.isValid() {
+		return 0, ErrInvalidPublicKey
+	}
+	return n, nil
+}
+
+// Bytes returns the compressed encoding of the signature, on SizeSignature bytes.
+func (sig *Signature) Bytes() []byte {
+	b := sig.S.Bytes()
+	return b[:]
+}
+
+// SetBytes sets sig from its compressed encoding and returns the number of
+// bytes read. The signature must be a point of the prime order subgroup.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	return sig.S.SetBytes(buf)
+}
@@ -0,0 +1,82 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"errors"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+const (
+	// DSTPop is the domain separation tag with which messages are hashed to G2
+	// in the proof of possession scheme, instead of DST.
+	DSTPop = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+	// DSTPopProve is the domain separation tag with which public keys are
+	// hashed to G2 by PopProve. It must differ from DSTPop so that a proof of
+	// possession can't be used as the signature of a message, and conversely.
+	DSTPopProve = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+// ErrNoSignature is returned when aggregating an empty list of signatures.
+var ErrNoSignature = errors.New("no signature to aggregate")
+
+// PopProve returns a proof of possession of sk: the signature of the encoding
+// of the public key under DSTPopProve.
+//
+// Each signer publishes it with its public key, and the proofs must be checked
+// with PopVerify before the keys are used in FastAggregateVerify: a rogue key,
+// chosen as a function of the other keys so as to cancel them in the
+// aggregation, can't come with a valid proof of possession.
+func PopProve(sk SecretKey) (Signature, error) {
+	pk := sk.Public()
+	return sign(sk, pk.Bytes(), []byte(DSTPopProve))
+}
+
+// PopVerify returns true if pop is a valid proof of possession for pk.
+func PopVerify(pk PublicKey, pop Signature) bool {
+	return verify(pk, pk.Bytes(), pop, []byte(DSTPopProve))
+}
+
+// PopSign returns the signature of msg for the proof of possession scheme,
+// which hashes messages with DSTPop.
+func PopSign(sk SecretKey, msg []byte) (Signature, error) {
+	return sign(sk, msg, []byte(DSTPop))
+}
+
+// Aggregate returns the sum of the signatures.
+func Aggregate(sigs []Signature) (Signature, error) {
+	if len(sigs) == 0 {
+		return Signature{}, ErrNoSignature
+	}
+	var acc bls12381.G2Jac
+	acc.FromAffine(&sigs[0].S)
+	for i := 1; i < len(sigs); i++ {
+		acc.AddMixed(&sigs[i].S)
+	}
+	var res Signature
+	res.S.FromJacobian(&acc)
+	return res, nil
+}
+
+// FastAggregateVerify returns true if sig is a valid aggregate of signatures of
+// the same message msg (see PopSign) under each of the public keys.
+//
+// It only checks a single signature against the sum of the public keys, and
+// is secure only if the proof of possession of each key has been checked with
+// PopVerify.
+func FastAggregateVerify(pks []PublicKey, msg []byte, sig Signature) bool {
+	if len(pks) == 0 {
+		return false
+	}
+	var acc bls12381.G1Jac
+	acc.FromAffine(&pks[0].A)
+	for i := 1; i < len(pks); i++ {
+		acc.AddMixed(&pks[i].A)
+	}
+	var pk PublicKey
+	pk.A.FromJacobian(&acc)
+	return verify(pk, msg, sig, []byte(DSTPop))
+}
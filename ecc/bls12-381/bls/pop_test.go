@@ -0,0 +1,107 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/stretchr/testify/require"
+)
+
+func testKeys(t *testing.T, n int) ([]SecretKey, []PublicKey) {
+	sks := make([]SecretKey, n)
+	pks := make([]PublicKey, n)
+	for i := range sks {
+		ikm := make([]byte, 32)
+		ikm[0] = byte(i)
+		var err error
+		sks[i], pks[i], err = KeyGen(ikm)
+		require.NoError(t, err)
+	}
+	return sks, pks
+}
+
+func TestPop(t *testing.T) {
+	assert := require.New(t)
+
+	sks, pks := testKeys(t, 2)
+	pop, err := PopProve(sks[0])
+	assert.NoError(err)
+	assert.True(PopVerify(pks[0], pop))
+	assert.False(PopVerify(pks[1], pop), "proof of possession accepted for another key")
+
+	// a proof of possession is not a signature of the encoded key, and conversely
+	sig, err := PopSign(sks[0], pks[0].Bytes())
+	assert.NoError(err)
+	assert.False(PopVerify(pks[0], sig))
+	assert.False(FastAggregateVerify(pks[:1], pks[0].Bytes(), pop))
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 4
+	sks, pks := testKeys(t, n)
+	msg := []byte("gnark-crypto multi-signature")
+	sigs := make([]Signature, n)
+	for i := range sks {
+		var err error
+		sigs[i], err = PopSign(sks[i], msg)
+		assert.NoError(err)
+	}
+	aggSig, err := Aggregate(sigs)
+	assert.NoError(err)
+
+	assert.True(FastAggregateVerify(pks, msg, aggSig))
+	assert.False(FastAggregateVerify(pks, []byte("another message"), aggSig))
+	assert.False(FastAggregateVerify(pks[1:], msg, aggSig), "missing signer accepted")
+	partial, err := Aggregate(sigs[1:])
+	assert.NoError(err)
+	assert.False(FastAggregateVerify(pks, msg, partial), "missing signature accepted")
+	assert.False(FastAggregateVerify(nil, msg, aggSig))
+
+	// signatures of the basic scheme use another DST
+	basicSig, err := Sign(sks[0], msg)
+	assert.NoError(err)
+	assert.False(FastAggregateVerify(pks[:1], msg, basicSig))
+
+	_, err = Aggregate(nil)
+	assert.ErrorIs(err, ErrNoSignature)
+}
+
+// TestRogueKey shows the attack prevented by the proofs of possession: a key
+// chosen to cancel an honest key in the aggregation passes FastAggregateVerify
+// without the honest signer, but has no valid proof of possession.
+func TestRogueKey(t *testing.T) {
+	assert := require.New(t)
+
+	sks, pks := testKeys(t, 2)
+	honest, attacker := pks[0], sks[1]
+	msg := []byte("gnark-crypto multi-signature")
+
+	// rogue = attacker⋅g₁ - honest
+	attackerPk := attacker.Public()
+	var rogue PublicKey
+	rogue.A.Sub(&attackerPk.A, &honest.A)
+
+	forged, err := PopSign(attacker, msg)
+	assert.NoError(err)
+	assert.True(FastAggregateVerify([]PublicKey{honest, rogue}, msg, forged))
+
+	// the attacker can only sign with its own secret key
+	pop, err := PopProve(attacker)
+	assert.NoError(err)
+	assert.False(PopVerify(rogue, pop))
+
+	// nor derive a proof for the rogue key from the honest one
+	h, err := bls12381.HashToG2(rogue.Bytes(), []byte(DSTPopProve))
+	assert.NoError(err)
+	var s big.Int
+	attacker.scalar.BigInt(&s)
+	var guess Signature
+	guess.S.ScalarMultiplication(&h, &s)
+	assert.False(PopVerify(rogue, guess))
+}
@@ -192,6 +192,18 @@ func Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
 	return
 }
 
+// G1Generator returns a copy of the affine generator of the r-torsion group in G1.
+// Modifying the returned point does not affect the package state.
+func G1Generator() G1Affine {
+	return g1GenAff
+}
+
+// G2Generator returns a copy of the affine generator of the r-torsion group in G2.
+// Modifying the returned point does not affect the package state.
+func G2Generator() G2Affine {
+	return g2GenAff
+}
+
 // CurveCoefficients returns the a, b coefficients of the curve equation.
 func CurveCoefficients() (a, b fp.Element) {
 	return aCurveCoeff, bCurveCoeff
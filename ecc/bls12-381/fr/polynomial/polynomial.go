@@ -6,6 +6,7 @@
 package polynomial
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +15,9 @@ import (
 	"github.com/consensys/gnark-crypto/utils"
 )
 
+// ErrZeroDivisor is returned when dividing by the zero polynomial.
+var ErrZeroDivisor = errors.New("division by the zero polynomial")
+
 // Polynomial represented by coefficients in the field.
 type Polynomial []fr.Element
 
@@ -150,6 +154,42 @@ func (p *Polynomial) Equal(p1 Polynomial) bool {
 	return true
 }
 
+// DivRem computes the euclidean division of p by divisor, using schoolbook
+// division. It returns quotient and remainder such that
+// p = quotient * divisor + remainder, with deg(remainder) < deg(divisor).
+//
+// Trailing zero coefficients of divisor are ignored. The remainder has
+// deg(divisor) coefficients (at least one), and the quotient
+// len(p) - deg(divisor) coefficients (at least one). p and divisor are not
+// modified. It returns ErrZeroDivisor if divisor is the zero polynomial.
+func (p *Polynomial) DivRem(divisor Polynomial) (quotient, remainder Polynomial, err error) {
+	d := len(divisor) - 1
+	for d >= 0 && divisor[d].IsZero() {
+		d--
+	}
+	if d < 0 {
+		return nil, nil, ErrZeroDivisor
+	}
+
+	r := p.Clone()
+	quotient = make(Polynomial, max(len(r)-d, 1))
+
+	var leadInv, c, t fr.Element
+	leadInv.Inverse(&divisor[d])
+	for i := len(r) - 1 - d; i >= 0; i-- {
+		c.Mul(&r[i+d], &leadInv)
+		quotient[i] = c
+		for j := 0; j <= d; j++ {
+			t.Mul(&c, &divisor[j])
+			r[i+j].Sub(&r[i+j], &t)
+		}
+	}
+
+	remainder = make(Polynomial, max(d, 1))
+	copy(remainder, r[:min(d, len(r))])
+	return quotient, remainder, nil
+}
+
 func (p Polynomial) SetZero() {
 	for i := 0; i < len(p); i++ {
 		p[i].SetZero()
@@ -0,0 +1,50 @@
+package poseidon2
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// testCase is a known-answer test for the Poseidon2 permutation. The round
+// keys are derived from the default seed, see [Parameters.String].
+type testCase struct {
+	Width           int      `json:"width"`
+	NbFullRounds    int      `json:"nbFullRounds"`
+	NbPartialRounds int      `json:"nbPartialRounds"`
+	In              []string `json:"in"`
+	Out             []string `json:"out"`
+}
+
+func TestVectors(t *testing.T) {
+
+	f, err := os.Open("test-vectors.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	var testCases []testCase
+	require.NoError(t, json.NewDecoder(f).Decode(&testCases))
+
+	for _, c := range testCases {
+		h := NewPermutation(c.Width, c.NbFullRounds, c.NbPartialRounds)
+		require.Len(t, c.In, c.Width)
+		require.Len(t, c.Out, c.Width)
+
+		state := make([]fr.Element, c.Width)
+		for i := range c.In {
+			_, err = state[i].SetString(c.In[i])
+			require.NoError(t, err)
+		}
+		require.NoError(t, h.Permutation(state))
+
+		for i := range c.Out {
+			var expected fr.Element
+			_, err = expected.SetString(c.Out[i])
+			require.NoError(t, err)
+			require.True(t, expected.Equal(&state[i]), "width %d, rF %d, rP %d: mismatch at index %d", c.Width, c.NbFullRounds, c.NbPartialRounds, i)
+		}
+	}
+}
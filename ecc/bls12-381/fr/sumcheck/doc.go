@@ -0,0 +1,22 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package sumcheck implements the sum-check protocol for multilinear polynomials over fr.Element.
+//
+// The prover convinces the verifier that the sum of a multilinear polynomial P in n variables
+// over the boolean hypercube {0,1}ⁿ equals a claimed value. The protocol runs in n rounds;
+// in round i the prover sends the degree-1 polynomial
+//
+//	gᵢ(X) = ∑_{b ∈ {0,1}ⁿ⁻ⁱ} P(r₁, ..., rᵢ₋₁, X, b)
+//
+// and the verifier checks gᵢ(0) + gᵢ(1) = gᵢ₋₁(rᵢ₋₁) before drawing the challenge rᵢ.
+// The challenges are derived non-interactively with a Fiat-Shamir transcript, to which
+// each round polynomial is bound.
+//
+// At the end, the claim on the sum is reduced to a single evaluation claim P(r₁, ..., rₙ) = v,
+// which the caller must check, typically through a polynomial commitment opening.
+//
+// See https://people.cs.georgetown.edu/jthaler/ProofsArgsAndZK.pdf, section 4.1.
+package sumcheck
@@ -0,0 +1,157 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package sumcheck
+
+import (
+	"errors"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidPolynomial = errors.New("sumcheck: polynomial size must be a power of two greater than 1")
+	ErrWrongClaimedSum   = errors.New("sumcheck: claimed sum does not match the polynomial")
+	ErrProofSize         = errors.New("sumcheck: proof does not match the number of variables")
+	ErrRoundSum          = errors.New("sumcheck: round polynomial does not match the previous claim")
+	ErrFinalEvaluation   = errors.New("sumcheck: final evaluation does not match the last round polynomial")
+)
+
+// Proof is a non-interactive sum-check proof.
+type Proof struct {
+	// RoundPolynomials[i] holds the evaluations at 0 and 1 of the (degree 1)
+	// polynomial sent by the prover in round i.
+	RoundPolynomials [][2]fr.Element
+
+	// FinalEvaluation is the claimed evaluation of the polynomial at the
+	// challenges point.
+	FinalEvaluation fr.Element
+}
+
+// ChallengeNames returns the names of the challenges of a sum-check on a polynomial
+// in nbVars variables. The transcript given to Prove and Verify must be created with
+// these names, in this order, e.g. fiatshamir.NewTranscript(h, ChallengeNames(nbVars)...).
+func ChallengeNames(nbVars int) []string {
+	res := make([]string, nbVars)
+	for i := range res {
+		res[i] = "sumcheck.r" + strconv.Itoa(i)
+	}
+	return res
+}
+
+// Prove returns a proof that the sum of poly over the boolean hypercube equals claimedSum.
+// poly is not modified.
+func Prove(poly polynomial.MultiLin, claimedSum fr.Element, transcript *fiatshamir.Transcript) (Proof, error) {
+	var proof Proof
+	nbVars, err := numVars(len(poly))
+	if err != nil {
+		return proof, err
+	}
+	challengeNames := ChallengeNames(nbVars)
+	if err := transcript.Bind(challengeNames[0], claimedSum.Marshal()); err != nil {
+		return proof, err
+	}
+
+	proof.RoundPolynomials = make([][2]fr.Element, nbVars)
+	table := poly.Clone()
+	for i := range nbVars {
+		// g(0) and g(1) are the sums of the evaluations with the current
+		// variable set to 0 (bottom half) and 1 (top half) respectively.
+		mid := len(table) / 2
+		g := &proof.RoundPolynomials[i]
+		g[0] = polynomial.MultiLin(table[:mid]).Sum()
+		g[1] = polynomial.MultiLin(table[mid:]).Sum()
+
+		if i == 0 {
+			var sum fr.Element
+			sum.Add(&g[0], &g[1])
+			if !sum.Equal(&claimedSum) {
+				return proof, ErrWrongClaimedSum
+			}
+		}
+
+		r, err := roundChallenge(transcript, challengeNames[i], g)
+		if err != nil {
+			return proof, err
+		}
+		table.Fold(r)
+	}
+	proof.FinalEvaluation = table[0]
+
+	return proof, nil
+}
+
+// Verify checks a sum-check proof that the sum of a multilinear polynomial in nbVars
+// variables over the boolean hypercube equals claimedSum.
+//
+// On success, it returns the challenges r = (r₁, ..., rₙ). The claim on the sum is then
+// reduced to the claim P(r) = proof.FinalEvaluation, which the caller must check
+// separately (e.g. with a polynomial commitment opening).
+func Verify(claimedSum fr.Element, nbVars int, proof Proof, transcript *fiatshamir.Transcript) ([]fr.Element, error) {
+	if nbVars < 1 || len(proof.RoundPolynomials) != nbVars {
+		return nil, ErrProofSize
+	}
+	challengeNames := ChallengeNames(nbVars)
+	if err := transcript.Bind(challengeNames[0], claimedSum.Marshal()); err != nil {
+		return nil, err
+	}
+
+	challenges := make([]fr.Element, nbVars)
+	claim := claimedSum
+	var sum fr.Element
+	for i := range nbVars {
+		g := &proof.RoundPolynomials[i]
+		sum.Add(&g[0], &g[1])
+		if !sum.Equal(&claim) {
+			return nil, ErrRoundSum
+		}
+
+		r, err := roundChallenge(transcript, challengeNames[i], g)
+		if err != nil {
+			return nil, err
+		}
+		challenges[i] = r
+
+		// g(r) = g(0) + r(g(1) - g(0))
+		claim.Sub(&g[1], &g[0]).
+			Mul(&claim, &r).
+			Add(&claim, &g[0])
+	}
+
+	if !claim.Equal(&proof.FinalEvaluation) {
+		return nil, ErrFinalEvaluation
+	}
+
+	return challenges, nil
+}
+
+// roundChallenge binds the round polynomial g to the transcript and returns the
+// corresponding challenge.
+func roundChallenge(transcript *fiatshamir.Transcript, name string, g *[2]fr.Element) (fr.Element, error) {
+	var r fr.Element
+	for j := range g {
+		if err := transcript.Bind(name, g[j].Marshal()); err != nil {
+			return r, err
+		}
+	}
+	b, err := transcript.ComputeChallenge(name)
+	if err != nil {
+		return r, err
+	}
+	r.SetBytes(b)
+	return r, nil
+}
+
+// numVars returns log₂(size), or an error if size is not a power of two greater than 1.
+func numVars(size int) (int, error) {
+	if size < 2 || size&(size-1) != 0 {
+		return 0, ErrInvalidPolynomial
+	}
+	return bits.TrailingZeros(uint(size)), nil
+}
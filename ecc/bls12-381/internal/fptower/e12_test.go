@@ -7,9 +7,11 @@ package fptower
 
 import (
 	"math/big"
+	"math/rand/v2"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -46,6 +48,31 @@ func TestE12Serialization(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestE12Rand(t *testing.T) {
+	t.Parallel()
+
+	var seed [32]byte
+	rng := rand.NewChaCha8(seed)
+
+	var a, b, c E12
+	if _, err := a.Rand(rng); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Rand(rng); err != nil {
+		t.Fatal(err)
+	}
+	if !a.IsInSubGroup() || !b.IsInSubGroup() {
+		t.Fatal("random element should be in GT")
+	}
+	if a.IsOne() || a.Equal(&b) {
+		t.Fatal("random elements should be non-trivial and differ")
+	}
+	c.Exp(a, fr.Modulus())
+	if !c.IsOne() {
+		t.Fatal("random element should have order r")
+	}
+}
+
 func TestE12ReceiverIsOperand(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
@@ -172,6 +199,82 @@ func TestE12ReceiverIsOperand(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestE12SparseMul(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE12()
+	genB := GenE12()
+	genE2 := GenE2()
+	properties.Property("[BLS12-381] MulBy014 should match Mul by (c0,c1,0,0,c4,0)", prop.ForAll(
+		func(a *E12, c0, c1, c4 *E2) bool {
+			var sparse, b, c E12
+			sparse.C0.B0.Set(c0)
+			sparse.C0.B1.Set(c1)
+			sparse.C1.B1.Set(c4)
+			b.Mul(a, &sparse)
+			c.Set(a).MulBy014(c0, c1, c4)
+			return b.Equal(&c)
+		},
+		genA,
+		genE2,
+		genE2,
+		genE2,
+	))
+
+	properties.Property("[BLS12-381] MulBy01 should match Mul by (c0,c1,0,0,1,0)", prop.ForAll(
+		func(a *E12, c0, c1 *E2) bool {
+			var sparse, b, c E12
+			sparse.C0.B0.Set(c0)
+			sparse.C0.B1.Set(c1)
+			sparse.C1.B1.SetOne()
+			b.Mul(a, &sparse)
+			c.Set(a).MulBy01(c0, c1)
+			return b.Equal(&c)
+		},
+		genA,
+		genE2,
+		genE2,
+	))
+
+	properties.Property("[BLS12-381] MulBy01245 should match Mul by (x0,x1,x2,0,x4,x5)", prop.ForAll(
+		func(a, s *E12) bool {
+			var b, c E12
+			s.C1.B0.SetZero()
+			x := [5]E2{s.C0.B0, s.C0.B1, s.C0.B2, s.C1.B1, s.C1.B2}
+			b.Mul(a, s)
+			c.Set(a).MulBy01245(&x)
+			return b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[BLS12-381] Mul014By014 followed by MulBy01245 should match two MulBy014", prop.ForAll(
+		func(a *E12, c0, c1, c4 *E2) bool {
+			var b, c E12
+			d0, d1, d4 := c4, c0, c1
+			b.Set(a).MulBy014(c0, c1, c4).MulBy014(d0, d1, d4)
+			x := Mul014By014(d0, d1, d4, c0, c1, c4)
+			c.Set(a).MulBy01245(&x)
+			return b.Equal(&c)
+		},
+		genA,
+		genE2,
+		genE2,
+		genE2,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func TestE12Ops(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
@@ -278,6 +381,43 @@ func TestE12Ops(t *testing.T) {
 		genA,
 	))
 
+	properties.Property("[BLS12-381] Torus-based compression should fail outside of the cyclotomic subgroup", prop.ForAll(
+		func(a, e *E12) bool {
+			// a random element is not in the cyclotomic subgroup with overwhelming probability
+			if _, err := a.CompressTorus(); err == nil {
+				return false
+			}
+
+			var b E12
+			b.Conjugate(e)
+			e.Inverse(e)
+			b.Mul(&b, e)
+			e.FrobeniusSquare(&b).Mul(e, &b)
+
+			_, err := BatchCompressTorus([]E12{*e, *a})
+			return err != nil
+		},
+		genA,
+		genA,
+	))
+
+	properties.Property("[BLS12-381] Expt should match Exp by the seed in the cyclotomic subgroup", prop.ForAll(
+		func(a *E12) bool {
+			var b, c, d E12
+			b.Conjugate(a)
+			a.Inverse(a)
+			b.Mul(&b, a)
+			a.FrobeniusSquare(&b).Mul(a, &b)
+
+			var seed big.Int
+			seed.SetString("-15132376222941642752", 10)
+			c.Expt(a)
+			d.Exp(*a, &seed)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+
 	properties.Property("[BLS12-381] pi**12=id", prop.ForAll(
 		func(a *E12) bool {
 			var b E12
@@ -435,6 +575,25 @@ func TestE12Ops(t *testing.T) {
 		genA,
 	))
 
+	properties.Property("[BLS12-381] SetStringChecked should round-trip decimal strings", prop.ForAll(
+		func(a *E12) bool {
+			var b E12
+			if _, err := b.SetStringChecked(
+				a.C0.B0.A0.String(), a.C0.B0.A1.String(),
+				a.C0.B1.A0.String(), a.C0.B1.A1.String(),
+				a.C0.B2.A0.String(), a.C0.B2.A1.String(),
+				a.C1.B0.A0.String(), a.C1.B0.A1.String(),
+				a.C1.B1.A0.String(), a.C1.B1.A1.String(),
+				a.C1.B2.A0.String(), a.C1.B2.A1.String(),
+			); err != nil {
+				return false
+			}
+			_, err := b.SetStringChecked("", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11")
+			return b.Equal(a) && err != nil
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -471,6 +630,31 @@ func BenchmarkE12Mul(b *testing.B) {
 		a.Mul(&a, &c)
 	}
 }
+func BenchmarkE12MulBy014(b *testing.B) {
+	var a E12
+	var c0, c1, c4 E2
+	a.MustSetRandom()
+	c0.MustSetRandom()
+	c1.MustSetRandom()
+	c4.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.MulBy014(&c0, &c1, &c4)
+	}
+}
+
+func BenchmarkE12MulBy01245(b *testing.B) {
+	var a E12
+	var x [5]E2
+	a.MustSetRandom()
+	for i := range x {
+		x[i].MustSetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.MulBy01245(&x)
+	}
+}
 
 func BenchmarkE12Cyclosquare(b *testing.B) {
 	var a E12
@@ -554,5 +738,26 @@ func TestE12Div(t *testing.T) {
 		genB,
 	))
 
+	properties.Property("[BLS12-381] dividing by zero should output zero", prop.ForAll(
+		func(a *E12) bool {
+			var c, zero E12
+			c.Div(a, &zero)
+			return c.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-381] dividing a non-zero element by itself should output one", prop.ForAll(
+		func(a *E12) bool {
+			if a.IsZero() {
+				return true
+			}
+			var c E12
+			c.Div(a, a)
+			return c.IsOne()
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
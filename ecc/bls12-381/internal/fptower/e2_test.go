@@ -396,6 +396,21 @@ func TestE2Ops(t *testing.T) {
 		genA,
 	))
 
+	properties.Property("[BLS12-381] SetStringChecked should round-trip decimal and hexadecimal strings", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			if _, err := b.SetStringChecked(a.A0.String(), a.A1.String()); err != nil {
+				return false
+			}
+			if _, err := c.SetStringChecked("0x"+a.A0.Text(16), "0x"+a.A1.Text(16)); err != nil {
+				return false
+			}
+			_, err := c.SetStringChecked("1", "not a number")
+			return b.Equal(a) && c.Equal(a) && err != nil
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -528,5 +543,26 @@ func TestE2Div(t *testing.T) {
 		genB,
 	))
 
+	properties.Property("[BLS12-381] dividing by zero should output zero", prop.ForAll(
+		func(a *E2) bool {
+			var c, zero E2
+			c.Div(a, &zero)
+			return c.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-381] dividing a non-zero element by itself should output one", prop.ForAll(
+		func(a *E2) bool {
+			if a.IsZero() {
+				return true
+			}
+			var c E2
+			c.Div(a, a)
+			return c.IsOne()
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
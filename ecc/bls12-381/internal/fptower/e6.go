@@ -23,6 +23,23 @@ func (z *E6) SetString(s1, s2, s3, s4, s5, s6 string) *E6 {
 	return z
 }
 
+// SetStringChecked sets a E6 elmt from strings, as SetString, but returns an error
+// if a string is not a valid number. In that case, z is left unchanged.
+func (z *E6) SetStringChecked(s1, s2, s3, s4, s5, s6 string) (*E6, error) {
+	var b0, b1, b2 E2
+	if _, err := b0.SetStringChecked(s1, s2); err != nil {
+		return nil, err
+	}
+	if _, err := b1.SetStringChecked(s3, s4); err != nil {
+		return nil, err
+	}
+	if _, err := b2.SetStringChecked(s5, s6); err != nil {
+		return nil, err
+	}
+	z.B0, z.B1, z.B2 = b0, b1, b2
+	return z, nil
+}
+
 // Set Sets a E6 elmt form another E6 elmt
 func (z *E6) Set(x *E6) *E6 {
 	z.B0 = x.B0
@@ -333,6 +350,8 @@ func (z *E6) Select(cond int, caseZ *E6, caseNz *E6) *E6 {
 }
 
 // Div divides an element in E6 by an element in E6
+//
+// if y == 0, sets and returns z = 0 (as Inverse maps 0 to 0)
 func (z *E6) Div(x *E6, y *E6) *E6 {
 	var r E6
 	r.Inverse(y).Mul(x, &r)
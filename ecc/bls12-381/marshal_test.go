@@ -8,10 +8,14 @@ package bls12381
 import (
 	"bytes"
 	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
 	"math/big"
 	"math/rand/v2"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/leanovate/gopter"
@@ -165,6 +169,152 @@ func TestEncoder(t *testing.T) {
 	testDecode(t, &bufRaw, encRaw.BytesWritten())
 
 }
+func TestZCashVectors(t *testing.T) {
+	t.Parallel()
+
+	// compressed encodings following the ZCash flag layout, as used by Ethereum
+	// consensus clients.
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	sk, _ := new(big.Int).SetString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3", 16)
+	var pk G1Affine
+	pk.ScalarMultiplicationBase(sk)
+
+	g1Vectors := []struct {
+		point   G1Affine
+		encoded string
+	}{
+		{g1GenAff, "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb"},
+		{G1Affine{}, "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},
+		// public key of an Ethereum BLS signature test vector
+		{pk, "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"},
+	}
+	for _, v := range g1Vectors {
+		b := v.point.Bytes()
+		if hex.EncodeToString(b[:]) != v.encoded {
+			t.Fatalf("G1 encoding mismatch: got %x, want %s", b, v.encoded)
+		}
+		var p G1Affine
+		if _, err := p.SetBytes(decode(v.encoded)); err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&v.point) {
+			t.Fatal("G1 decoding mismatch")
+		}
+	}
+
+	g2Vectors := []struct {
+		point   G2Affine
+		encoded string
+	}{
+		{g2GenAff, "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8"},
+		{G2Affine{}, "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+	for _, v := range g2Vectors {
+		b := v.point.Bytes()
+		if hex.EncodeToString(b[:]) != v.encoded {
+			t.Fatalf("G2 encoding mismatch: got %x, want %s", b, v.encoded)
+		}
+		var p G2Affine
+		if _, err := p.SetBytes(decode(v.encoded)); err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&v.point) {
+			t.Fatal("G2 decoding mismatch")
+		}
+	}
+}
+
+func TestDecodeG1Slice(t *testing.T) {
+	t.Parallel()
+
+	const n = 100
+	var s fr.Element
+	s.MustSetRandom()
+	scalars := make([]fr.Element, n)
+	for i := range scalars {
+		scalars[i].SetUint64(uint64(i)).Mul(&scalars[i], &s)
+	}
+	points := BatchScalarMultiplicationG1(&g1GenAff, scalars)
+	points[1].SetInfinity()
+
+	// mix compressed and uncompressed encodings
+	encode := func(points []G1Affine) []byte {
+		var buf bytes.Buffer
+		for i := range points {
+			if i%2 == 0 {
+				b := points[i].Bytes()
+				buf.Write(b[:])
+			} else {
+				b := points[i].RawBytes()
+				buf.Write(b[:])
+			}
+		}
+		return buf.Bytes()
+	}
+	data := encode(points)
+
+	for _, check := range []SubGroupCheckMode{FullCheck, BatchCheck, NoCheck} {
+		decoded, err := DecodeG1Slice(bytes.NewReader(data), n, check)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(points, decoded) {
+			t.Fatalf("mode %d: decoded points don't match", check)
+		}
+	}
+
+	if _, err := DecodeG1Slice(bytes.NewReader(data), n+1, FullCheck); err == nil {
+		t.Fatal("expected an error on a short stream")
+	}
+
+	// find a point on the curve which is not in the subgroup
+	var offSubGroup G1Affine
+	found := false
+	for x := uint64(1); x < 256 && !found; x++ {
+		var rhs fp.Element
+		offSubGroup.X.SetUint64(x)
+		rhs.Square(&offSubGroup.X).Mul(&rhs, &offSubGroup.X).Add(&rhs, &bCurveCoeff)
+		if offSubGroup.Y.Sqrt(&rhs) == nil {
+			continue
+		}
+		found = offSubGroup.IsOnCurve() && !offSubGroup.IsInSubGroup()
+	}
+	if !found {
+		t.Skip("no point outside of the subgroup found, G1 cofactor is likely 1")
+	}
+
+	const bad = 42
+	for _, bad := range []int{bad, bad + 1} { // compressed and uncompressed
+		invalid := make([]G1Affine, n)
+		copy(invalid, points)
+		invalid[bad] = offSubGroup
+		data := encode(invalid)
+
+		_, err := DecodeG1Slice(bytes.NewReader(data), n, FullCheck)
+		if err == nil {
+			t.Fatal("FullCheck should reject a point outside of the subgroup")
+		}
+		if !strings.Contains(err.Error(), fmt.Sprintf("point %d:", bad)) {
+			t.Fatalf("FullCheck error should identify the point %d: %v", bad, err)
+		}
+		if _, err = DecodeG1Slice(bytes.NewReader(data), n, BatchCheck); err == nil {
+			t.Fatal("BatchCheck should reject a point outside of the subgroup")
+		}
+		decoded, err := DecodeG1Slice(bytes.NewReader(data), n, NoCheck)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !decoded[bad].Equal(&offSubGroup) {
+			t.Fatal("NoCheck should decode the point outside of the subgroup")
+		}
+	}
+}
 
 func TestIsCompressed(t *testing.T) {
 	t.Parallel()
@@ -252,6 +402,58 @@ func TestG1AffineInvalidBitMask(t *testing.T) {
 	}
 }
 
+func TestG1AffineJSON(t *testing.T) {
+	t.Parallel()
+
+	type S struct {
+		A G1Affine
+		B *G1Affine
+		C *G1Affine
+	}
+
+	var s S
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	s.A.ScalarMultiplication(&g1GenAff, &k)
+	s.C = new(G1Affine) // infinity
+
+	encoded, err := json.Marshal(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded S
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !s.A.Equal(&decoded.A) || decoded.B != nil || !decoded.C.IsInfinity() {
+		t.Fatal("point -> json -> point round trip failed")
+	}
+
+	// uncompressed form is accepted too
+	raw := s.A.RawBytes()
+	var p G1Affine
+	if err := json.Unmarshal([]byte("\"0x"+hex.EncodeToString(raw[:])+"\""), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&s.A) {
+		t.Fatal("uncompressed json -> point failed")
+	}
+
+	// malformed inputs are rejected
+	compressed := s.A.Bytes()
+	for _, in := range []string{
+		"42",
+		"\"" + hex.EncodeToString(compressed[:]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:len(compressed)-1]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:]) + "00\"",
+		"\"0xzz\"",
+	} {
+		if err := json.Unmarshal([]byte(in), &p); err == nil {
+			t.Fatalf("expected error when decoding %s", in)
+		}
+	}
+}
+
 func TestG1AffineSerialization(t *testing.T) {
 	t.Parallel()
 	// test round trip serialization of infinity
@@ -342,7 +544,67 @@ func TestG1AffineSerialization(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G1] Affine SetBytesUnchecked should output the same result as SetBytes", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, checked, unchecked G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			raw, compressed := start.RawBytes(), start.Bytes()
+			for _, buf := range [][]byte{raw[:], compressed[:]} {
+				n1, err := checked.SetBytes(buf)
+				if err != nil {
+					return false
+				}
+				n2, err := unchecked.SetBytesUnchecked(buf)
+				if err != nil {
+					return false
+				}
+				if n1 != n2 || !checked.Equal(&unchecked) {
+					return false
+				}
+			}
+			return true
+		},
+		GenFp(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// a point off the curve is only accepted by SetBytesUnchecked
+	var p, q G1Affine
+	p.Set(&g1GenAff)
+	p.Y.Double(&p.Y)
+	buf := p.RawBytes()
+	if _, err := q.SetBytes(buf[:]); err == nil {
+		t.Fatal("SetBytes should reject a point off the curve")
+	}
+	if _, err := q.SetBytesUnchecked(buf[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("SetBytesUnchecked should set the coordinates as is")
+	}
+}
+
+func BenchmarkG1AffineSetBytes(b *testing.B) {
+	var p G1Affine
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	p.ScalarMultiplication(&g1GenAff, &k)
+	raw := p.RawBytes()
+
+	b.Run("checked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytes(raw[:])
+		}
+	})
+	b.Run("unchecked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytesUnchecked(raw[:])
+		}
+	})
 }
 
 func TestG2AffineInvalidBitMask(t *testing.T) {
@@ -365,6 +627,58 @@ func TestG2AffineInvalidBitMask(t *testing.T) {
 	}
 }
 
+func TestG2AffineJSON(t *testing.T) {
+	t.Parallel()
+
+	type S struct {
+		A G2Affine
+		B *G2Affine
+		C *G2Affine
+	}
+
+	var s S
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	s.A.ScalarMultiplication(&g2GenAff, &k)
+	s.C = new(G2Affine) // infinity
+
+	encoded, err := json.Marshal(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded S
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !s.A.Equal(&decoded.A) || decoded.B != nil || !decoded.C.IsInfinity() {
+		t.Fatal("point -> json -> point round trip failed")
+	}
+
+	// uncompressed form is accepted too
+	raw := s.A.RawBytes()
+	var p G2Affine
+	if err := json.Unmarshal([]byte("\"0x"+hex.EncodeToString(raw[:])+"\""), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&s.A) {
+		t.Fatal("uncompressed json -> point failed")
+	}
+
+	// malformed inputs are rejected
+	compressed := s.A.Bytes()
+	for _, in := range []string{
+		"42",
+		"\"" + hex.EncodeToString(compressed[:]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:len(compressed)-1]) + "\"",
+		"\"0x" + hex.EncodeToString(compressed[:]) + "00\"",
+		"\"0xzz\"",
+	} {
+		if err := json.Unmarshal([]byte(in), &p); err == nil {
+			t.Fatalf("expected error when decoding %s", in)
+		}
+	}
+}
+
 func TestG2AffineSerialization(t *testing.T) {
 	t.Parallel()
 	// test round trip serialization of infinity
@@ -455,7 +769,67 @@ func TestG2AffineSerialization(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G2] Affine SetBytesUnchecked should output the same result as SetBytes", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, checked, unchecked G2Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			raw, compressed := start.RawBytes(), start.Bytes()
+			for _, buf := range [][]byte{raw[:], compressed[:]} {
+				n1, err := checked.SetBytes(buf)
+				if err != nil {
+					return false
+				}
+				n2, err := unchecked.SetBytesUnchecked(buf)
+				if err != nil {
+					return false
+				}
+				if n1 != n2 || !checked.Equal(&unchecked) {
+					return false
+				}
+			}
+			return true
+		},
+		GenFp(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// a point off the curve is only accepted by SetBytesUnchecked
+	var p, q G2Affine
+	p.Set(&g2GenAff)
+	p.Y.Double(&p.Y)
+	buf := p.RawBytes()
+	if _, err := q.SetBytes(buf[:]); err == nil {
+		t.Fatal("SetBytes should reject a point off the curve")
+	}
+	if _, err := q.SetBytesUnchecked(buf[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("SetBytesUnchecked should set the coordinates as is")
+	}
+}
+
+func BenchmarkG2AffineSetBytes(b *testing.B) {
+	var p G2Affine
+	var k big.Int
+	k.SetUint64(rand.Uint64())
+	p.ScalarMultiplication(&g2GenAff, &k)
+	raw := p.RawBytes()
+
+	b.Run("checked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytes(raw[:])
+		}
+	})
+	b.Run("unchecked", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.SetBytesUnchecked(raw[:])
+		}
+	})
 }
 
 // define Gopters generators
@@ -7,6 +7,7 @@ package twistededwards
 
 import (
 	"crypto/subtle"
+	"errors"
 	"io"
 	"math/big"
 	"math/bits"
@@ -14,6 +15,8 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 )
 
+var errNotOnCurve = errors.New("invalid compressed point: not on the curve")
+
 // PointAffine point on a twisted Edwards curve
 type PointAffine struct {
 	X, Y fr.Element
@@ -118,6 +121,78 @@ func (p *PointAffine) SetBytes(buf []byte) (int, error) {
 	return sizePointCompressed, nil
 }
 
+// BytesCompressed returns the compressed point as a byte slice, following
+// https://tools.ietf.org/html/rfc8032#section-5.1.2: the y-coordinate is
+// encoded in little endian and the msb of the last byte is set to the least
+// significant bit of x.
+//
+// Note that this differs from Bytes(), where the msb is set when x is
+// lexicographically largest.
+func (p *PointAffine) BytesCompressed() []byte {
+	y := p.Y.Bytes()
+	x := p.X.Bytes()
+
+	y[0] |= (x[sizePointCompressed-1] & 1) << 7 // msb of y
+	for i, j := 0, sizePointCompressed-1; i < j; i, j = i+1, j-1 {
+		y[i], y[j] = y[j], y[i]
+	}
+	return y[:]
+}
+
+// SetBytesCompressed sets p from buf, encoded as in BytesCompressed().
+// len(buf) >= sizePointCompressed
+// Returns the number of read bytes and an error if the buffer is too short, if
+// the y-coordinate is not canonical, or if no x-coordinate with the given least
+// significant bit satisfies the curve equation.
+func (p *PointAffine) SetBytesCompressed(buf []byte) (int, error) {
+	initOnce.Do(initCurveParams)
+
+	if len(buf) < sizePointCompressed {
+		return 0, io.ErrShortBuffer
+	}
+	bufCopy := make([]byte, sizePointCompressed)
+	copy(bufCopy, buf[:sizePointCompressed])
+	for i, j := 0, sizePointCompressed-1; i < j; i, j = i+1, j-1 {
+		bufCopy[i], bufCopy[j] = bufCopy[j], bufCopy[i]
+	}
+	xLsb := bufCopy[0] >> 7
+	bufCopy[0] &= mUnmask
+
+	var y fr.Element
+	if err := y.SetBytesCanonical(bufCopy); err != nil {
+		return 0, err
+	}
+
+	// x² = (1-y²)/(a-d*y²)
+	var one, num, den, x fr.Element
+	one.SetOne()
+	num.Square(&y)
+	den.Mul(&num, &curveParams.D)
+	num.Sub(&one, &num)
+	den.Sub(&curveParams.A, &den)
+	if den.IsZero() {
+		return 0, errNotOnCurve
+	}
+	x.Div(&num, &den)
+	if x.Sqrt(&x) == nil {
+		return 0, errNotOnCurve
+	}
+
+	// x = 0 has no negative counterpart
+	if x.IsZero() && xLsb == 1 {
+		return 0, errNotOnCurve
+	}
+	xBytes := x.Bytes()
+	if xBytes[sizePointCompressed-1]&1 != xLsb {
+		x.Neg(&x)
+	}
+
+	p.X.Set(&x)
+	p.Y.Set(&y)
+
+	return sizePointCompressed, nil
+}
+
 // Unmarshal alias to SetBytes()
 func (p *PointAffine) Unmarshal(b []byte) error {
 	_, err := p.SetBytes(b)
@@ -257,38 +332,76 @@ func (p *PointAffine) FromExtended(p1 *PointExtended) *PointAffine {
 
 // ScalarMultiplication scalar multiplication of a point
 // p1 in affine coordinates with a scalar in big.Int
+//
+// The computation is done in extended coordinates with a fixed window, see
+// [PointExtended.scalarMulWindowed]. It doesn't reduce the scalar, so it is
+// correct for any point on the curve, including small torsion points.
 func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *PointAffine {
-	return p.scalarMulWindowed(p1, scalar)
+	var _p PointExtended
+	_p.FromAffine(p1)
+	_p.scalarMulWindowed(&_p, scalar)
+	p.FromExtended(&_p)
+	return p
 }
 
-// scalarMulWindowed scalar multiplication of a point
-// p1 in affine coordinates with a scalar in big.Int
-// using the windowed double-and-add method.
-func (p *PointAffine) scalarMulWindowed(p1 *PointAffine, scalar *big.Int) *PointAffine {
-	var _scalar big.Int
-	_scalar.Set(scalar)
-	p.Set(p1)
-	if _scalar.Sign() == -1 {
-		_scalar.Neg(&_scalar)
-		p.Neg(p)
-	}
-	var resExtended PointExtended
-	resExtended.setInfinity()
+// ScalarMulLadder sets p to [s]base using a Montgomery ladder on extended
+// coordinates and returns p.
+//
+// The ladder runs a fixed number of iterations (the bit size of the scalar
+// field, or of s if larger), each made of one unified addition and one
+// doubling, and the ladder state is swapped with constant-time selections.
+// The result is converted to affine coordinates with a Fermat inversion
+// instead of the faster, variable-time, Inverse.
+// There is no secret-dependent branch or memory access in the field
+// arithmetic, so this method can be used when s is secret. The sign of s is
+// also handled without branching; note however that math/big itself is not
+// constant time, so s should have a fixed size representation, e.g. be reduced
+// modulo the curve order.
+//
+// [0]base and [s]O are the neutral element (0,1).
+func (p *PointAffine) ScalarMulLadder(base *PointAffine, s *big.Int) *PointAffine {
+	var scalar big.Int
+	scalar.Abs(s)
+
+	// negate the base if s < 0, without branching on the sign
+	var b PointAffine
+	var negX fr.Element
+	negX.Neg(&base.X)
+	b.X.Select(int(s.Sign()>>1&1), &base.X, &negX)
+	b.Y.Set(&base.Y)
+
+	// pad the scalar to a fixed number of words
 	const wordSize = bits.UintSize
-	sWords := _scalar.Bits()
-
-	for i := len(sWords) - 1; i >= 0; i-- {
-		ithWord := sWords[i]
-		for k := 0; k < wordSize; k++ {
-			resExtended.Double(&resExtended)
-			kthBit := (ithWord >> (wordSize - 1 - k)) & 1
-			if kthBit == 1 {
-				resExtended.MixedAdd(&resExtended, p)
-			}
+	nbWords := (fr.Bits + wordSize - 1) / wordSize
+	words := scalar.Bits()
+	if len(words) > nbWords {
+		nbWords = len(words)
+	}
+	padded := make([]big.Word, nbWords)
+	copy(padded, words)
+
+	var r0, r1 PointExtended
+	r0.setInfinity()
+	r1.FromAffine(&b)
+	for i := nbWords - 1; i >= 0; i-- {
+		w := padded[i]
+		for k := wordSize - 1; k >= 0; k-- {
+			bit := int((w >> k) & 1)
+			// invariant: r1 - r0 = b
+			r0.conditionalSwap(&r1, bit)
+			r1.Add(&r0, &r1)
+			r0.Double(&r0)
+			r0.conditionalSwap(&r1, bit)
 		}
 	}
 
-	p.FromExtended(&resExtended)
+	// Z ≠ 0 on the complete curve, Z⁻¹ = Z^(q-2)
+	var qMinus2 big.Int
+	qMinus2.Sub(fr.Modulus(), big.NewInt(2))
+	var zInv fr.Element
+	zInv.Exp(r0.Z, &qMinus2)
+	p.X.Mul(&r0.X, &zInv)
+	p.Y.Mul(&r0.Y, &zInv)
 	return p
 }
 
@@ -629,30 +742,58 @@ func (p *PointExtended) setInfinity() *PointExtended {
 	return p
 }
 
+// conditionalSwap swaps p and q if c == 1 and leaves them unchanged if
+// c == 0, in constant time.
+func (p *PointExtended) conditionalSwap(q *PointExtended, c int) {
+	var t PointExtended
+	t.X.Select(c, &p.X, &q.X)
+	t.Y.Select(c, &p.Y, &q.Y)
+	t.Z.Select(c, &p.Z, &q.Z)
+	t.T.Select(c, &p.T, &q.T)
+	q.X.Select(c, &q.X, &p.X)
+	q.Y.Select(c, &q.Y, &p.Y)
+	q.Z.Select(c, &q.Z, &p.Z)
+	q.T.Select(c, &q.T, &p.T)
+	p.Set(&t)
+}
+
 // scalarMulWindowed scalar multiplication of a point
 // p1 in extended coordinates with a scalar in big.Int
-// using the windowed double-and-add method.
+// using a fixed window of 4 bits: the multiples [1..15]p1 are
+// precomputed, and each window costs 4 doublings and at most
+// one unified addition.
 func (p *PointExtended) scalarMulWindowed(p1 *PointExtended, scalar *big.Int) *PointExtended {
+	const windowSize = 4
 	var _scalar big.Int
 	_scalar.Set(scalar)
-	p.Set(p1)
+	var base PointExtended
+	base.Set(p1)
 	if _scalar.Sign() == -1 {
 		_scalar.Neg(&_scalar)
-		p.Neg(p)
+		base.Neg(&base)
+	}
+
+	// table[i] = [i+1]base
+	var table [(1 << windowSize) - 1]PointExtended
+	table[0].Set(&base)
+	table[1].Double(&base)
+	for i := 2; i < len(table); i++ {
+		table[i].Add(&table[i-1], &base)
 	}
+
 	var resExtended PointExtended
 	resExtended.setInfinity()
-	const wordSize = bits.UintSize
-	sWords := _scalar.Bits()
-
-	for i := len(sWords) - 1; i >= 0; i-- {
-		ithWord := sWords[i]
-		for k := 0; k < wordSize; k++ {
+	nbWindows := (_scalar.BitLen() + windowSize - 1) / windowSize
+	for i := nbWindows - 1; i >= 0; i-- {
+		for j := 0; j < windowSize; j++ {
 			resExtended.Double(&resExtended)
-			kthBit := (ithWord >> (wordSize - 1 - k)) & 1
-			if kthBit == 1 {
-				resExtended.Add(&resExtended, p)
-			}
+		}
+		digit := uint(0)
+		for k := windowSize - 1; k >= 0; k-- {
+			digit = digit<<1 | _scalar.Bit(i*windowSize+k)
+		}
+		if digit != 0 {
+			resExtended.Add(&resExtended, &table[digit-1])
 		}
 	}
 
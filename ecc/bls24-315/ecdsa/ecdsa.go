@@ -11,6 +11,7 @@ import (
 	"crypto/rand"
 	"crypto/sha512"
 	"crypto/subtle"
+	"errors"
 	"hash"
 	"io"
 	"math/big"
@@ -30,6 +31,9 @@ const (
 	sizeSignature  = 2 * sizeFr
 )
 
+// errNilHash is returned when a hash function is required but none is given.
+var errNilHash = errors.New("nil hash function")
+
 var order = fr.Modulus()
 
 // PublicKey represents an ECDSA public key
@@ -261,6 +265,173 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 	return sig.Bytes(), nil
 }
 
+// SignRFC6979 performs the ECDSA signature according to [SEC 1] Section 4.1.3,
+// with the nonce k derived deterministically from the private key and the hash
+// of the message as specified in [RFC 6979].
+//
+// The argument h is used both to hash the message and to instantiate the
+// HMAC_DRBG generating k, so it must not be nil. Candidates for k which are
+// zero or not smaller than the order are rejected and the next one is derived,
+// and so are the ones leading to r = 0 or s = 0.
+//
+// As for Sign, the signature satisfies s <= (order-1)/2. Since k is fixed, this
+// is enforced by negating s rather than by sampling a new nonce.
+//
+// [SEC 1]: https://www.secg.org/sec1-v2.pdf
+// [RFC 6979]: https://www.rfc-editor.org/rfc/rfc6979
+func (privKey *PrivateKey) SignRFC6979(message []byte, h hash.Hash) (Signature, error) {
+	var sig Signature
+	if h == nil {
+		return sig, errNilHash
+	}
+
+	// compute the hash of the message
+	h.Reset()
+	if _, err := h.Write(message); err != nil {
+		return sig, err
+	}
+	hramBin := h.Sum(nil)
+	m := HashToInt(hramBin)
+
+	scalar := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+	bHalfR := new(big.Int).Rsh(order, 1)
+
+	drbg := newHMACDRBG(h, privKey.scalar[:sizeFr], bits2octets(hramBin))
+	r, s, kInv := new(big.Int), new(big.Int), new(big.Int)
+	for {
+		k := drbg.nonce()
+
+		var P bls24315.G1Affine
+		P.ScalarMultiplicationBase(k)
+		P.X.BigInt(r)
+		r.Mod(r, order)
+		if r.Sign() == 0 {
+			continue
+		}
+
+		kInv.ModInverse(k, order)
+		s.Mul(r, scalar).
+			Add(m, s).
+			Mul(kInv, s).
+			Mod(s, order) // order != 0
+		if s.Sign() != 0 {
+			break
+		}
+	}
+
+	// ensure s <= (r-1)/2 to prevent malleability
+	if s.Cmp(bHalfR) == 1 {
+		s.Sub(order, s)
+	}
+
+	r.FillBytes(sig.R[:sizeFr])
+	s.FillBytes(sig.S[:sizeFr])
+
+	return sig, nil
+}
+
+// hmacDRBG is the HMAC_DRBG of [RFC 6979] Section 3.2, generating the
+// candidate nonces.
+//
+// [RFC 6979]: https://www.rfc-editor.org/rfc/rfc6979
+type hmacDRBG struct {
+	h    hash.Hash
+	k, v []byte
+}
+
+// newHMACDRBG seeds the generator with the private key x and the reduced
+// message hash h1, both encoded on sizeFr bytes (steps b. to g.).
+func newHMACDRBG(h hash.Hash, x, h1 []byte) *hmacDRBG {
+	size := h.Size()
+	d := &hmacDRBG{
+		h: h,
+		k: make([]byte, size),
+		v: make([]byte, size),
+	}
+	for i := range d.v {
+		d.v[i] = 0x01
+	}
+	d.k = d.mac(d.v, []byte{0x00}, x, h1)
+	d.v = d.mac(d.v)
+	d.k = d.mac(d.v, []byte{0x01}, x, h1)
+	d.v = d.mac(d.v)
+	return d
+}
+
+// nonce returns the next candidate k in [1, order-1] (step h.). The state is
+// updated after each candidate, so that a subsequent call yields a new one.
+func (d *hmacDRBG) nonce() *big.Int {
+	for {
+		t := make([]byte, 0, sizeFr+len(d.v))
+		for len(t) < sizeFr {
+			d.v = d.mac(d.v)
+			t = append(t, d.v...)
+		}
+		k := bits2int(t[:sizeFr])
+
+		d.k = d.mac(d.v, []byte{0x00})
+		d.v = d.mac(d.v)
+
+		if k.Sign() != 0 && k.Cmp(order) < 0 {
+			return k
+		}
+	}
+}
+
+// mac returns HMAC_K(data[0] || data[1] || ...) with K the current key.
+func (d *hmacDRBG) mac(data ...[]byte) []byte {
+	key := d.k
+	if len(key) > d.h.BlockSize() {
+		d.h.Reset()
+		d.h.Write(key)
+		key = d.h.Sum(nil)
+	}
+	pad := make([]byte, d.h.BlockSize())
+	copy(pad, key)
+
+	// inner hash
+	for i := range pad {
+		pad[i] ^= 0x36
+	}
+	d.h.Reset()
+	d.h.Write(pad)
+	for _, b := range data {
+		d.h.Write(b)
+	}
+	inner := d.h.Sum(nil)
+
+	// outer hash
+	for i := range pad {
+		pad[i] ^= 0x36 ^ 0x5c
+	}
+	d.h.Reset()
+	d.h.Write(pad)
+	d.h.Write(inner)
+	return d.h.Sum(nil)
+}
+
+// bits2int converts a bit string to an integer, keeping its leftmost
+// bit-length of the order bits ([RFC 6979] Section 2.3.2).
+//
+// [RFC 6979]: https://www.rfc-editor.org/rfc/rfc6979
+func bits2int(b []byte) *big.Int {
+	ret := new(big.Int).SetBytes(b)
+	if excess := len(b)*8 - sizeFrBits; excess > 0 {
+		ret.Rsh(ret, uint(excess))
+	}
+	return ret
+}
+
+// bits2octets converts a bit string to an integer reduced modulo the order and
+// encoded on sizeFr bytes ([RFC 6979] Section 2.3.4).
+//
+// [RFC 6979]: https://www.rfc-editor.org/rfc/rfc6979
+func bits2octets(b []byte) []byte {
+	z := bits2int(b)
+	z.Mod(z, order)
+	return z.FillBytes(make([]byte, sizeFr))
+}
+
 // Verify validates the ECDSA signature according to [SEC 1] Section 4.1.4.
 //
 // The argument hFunc defines the hash function for computing the hash of the
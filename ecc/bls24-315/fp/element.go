@@ -8,13 +8,13 @@ package fp
 import (
 	"crypto/rand"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"io"
 	"math/big"
 	"math/bits"
 	"reflect"
 	"strconv"
-	"strings"
 
 	"github.com/bits-and-blooms/bitset"
 	"github.com/consensys/gnark-crypto/field/hash"
@@ -210,6 +210,9 @@ func (z *Element) Div(x, y *Element) *Element {
 }
 
 // Equal returns z == x; constant-time
+//
+// The limb differences are OR-ed together before a single zero check, so the
+// comparison does not short-circuit on the first differing limb.
 func (z *Element) Equal(x *Element) bool {
 	return z.NotEqual(x) == 0
 }
@@ -224,6 +227,13 @@ func (z *Element) IsZero() bool {
 	return (z[4] | z[3] | z[2] | z[1] | z[0]) == 0
 }
 
+// IsZeroConstantTime returns 1 if z == 0 and 0 otherwise; constant-time
+func (z *Element) IsZeroConstantTime() int {
+	t := z[4] | z[3] | z[2] | z[1] | z[0]
+	// the most significant bit of t | -t is set iff t != 0
+	return int(((t | -t) >> 63) ^ 1)
+}
+
 // IsOne returns z == 1
 func (z *Element) IsOne() bool {
 	return ((z[4] ^ 273027911707369796) | (z[3] ^ 2147590337827202454) | (z[2] ^ 16275985398192697234) | (z[1] ^ 5736013404040042110) | (z[0] ^ 15345841078474375115)) == 0
@@ -308,6 +318,17 @@ func (z *Element) LexicographicallyLargest() bool {
 // This might error only if reading from crypto/rand.Reader errors,
 // in which case, value of z is undefined.
 func (z *Element) SetRandom() (*Element, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom sets z to a uniform random value in [0, q), using r as the
+// source of randomness. Candidates are sampled from r and rejected until one
+// is in range, so that there is no modulo bias; the number of bytes read from r
+// is thus variable.
+//
+// This is useful to get reproducible values from a seeded reader. It errors only
+// if reading from r errors, in which case, value of z is undefined.
+func (z *Element) SetRandomFrom(r io.Reader) (*Element, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
@@ -330,7 +351,7 @@ func (z *Element) SetRandom() (*Element, error) {
 
 	for {
 		// note that bytes[k:l] is always 0
-		if _, err := io.ReadFull(rand.Reader, bytes[:k]); err != nil {
+		if _, err := io.ReadFull(r, bytes[:k]); err != nil {
 			return nil, err
 		}
 
@@ -733,12 +754,24 @@ func _reduceGeneric(z *Element) {
 	}
 }
 
-// BatchInvert returns a new slice with every element inverted.
-// Uses Montgomery batch inversion trick
+// BatchInvert returns a new slice with every element inverted; zero elements
+// are left as zero.
+//
+// It uses Montgomery batch inversion trick, at the cost of a single inversion
+// and 3 multiplications per element. Large slices are split in chunks which
+// are inverted in parallel, with one inversion per chunk.
 func BatchInvert(a []Element) []Element {
 	res := make([]Element, len(a))
+	execute(len(a), func(start, end int) {
+		batchInvert(res[start:end], a[start:end])
+	}, batchNbTasks(len(a)))
+	return res
+}
+
+// batchInvert sets res[i] = a[i]⁻¹ (0 if a[i] = 0) with a single inversion.
+func batchInvert(res, a []Element) {
 	if len(a) == 0 {
-		return res
+		return
 	}
 
 	zeroes := bitset.New(uint(len(a)))
@@ -762,8 +795,6 @@ func BatchInvert(a []Element) []Element {
 		res[i].Mul(&res[i], &accumulator)
 		accumulator.Mul(&accumulator, &a[i])
 	}
-
-	return res
 }
 
 func _butterflyGeneric(a, b *Element) {
@@ -791,32 +822,56 @@ func (z *Element) BitLen() int {
 }
 
 // Hash msg to count prime field elements.
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
+//
+// This is hash_to_field of RFC 9380 (section 5.2) with expand_message_xmd and SHA-256:
+// a single expansion produces count·L bytes, which are sliced and reduced into count
+// elements. For an extension of degree m, call it with count·m and group the
+// elements by m (e.g. 4 elements for 2 elements of 𝔽p²).
+//
+// With count = 1, this is the recommended way to derive a single element (e.g. a
+// challenge) from arbitrary data: unlike reducing a digest with SetBytes, the
+// expansion yields 128 extra bits so that the result is statistically close to uniform.
+// https://www.rfc-editor.org/rfc/rfc9380.html#section-5.2
 func Hash(msg, dst []byte, count int) ([]Element, error) {
-	// 128 bits of security
-	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
-	const Bytes = 1 + (Bits-1)/8
-	const L = 16 + Bytes
+	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, count*hashL)
+	if err != nil {
+		return nil, err
+	}
+	return fromPseudoRandomBytes(pseudoRandomBytes, count), nil
+}
 
-	lenInBytes := count * L
-	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, lenInBytes)
+// HashReader is [Hash] for a message read from r until io.EOF.
+//
+// The message is streamed through expand_message_xmd instead of being buffered,
+// so that it can be arbitrarily large. HashReader(bytes.NewReader(msg), dst, count)
+// returns the same elements as Hash(msg, dst, count).
+func HashReader(r io.Reader, dst []byte, count int) ([]Element, error) {
+	pseudoRandomBytes, err := hash.ExpandMsgXmdReader(r, dst, count*hashL)
 	if err != nil {
 		return nil, err
 	}
+	return fromPseudoRandomBytes(pseudoRandomBytes, count), nil
+}
 
+// hashL is the number of pseudo-random bytes reduced into each element by Hash, for 128 bits of security:
+// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
+const hashL = 16 + 1 + (Bits-1)/8
+
+// fromPseudoRandomBytes reduces count consecutive chunks of hashL bytes into elements.
+func fromPseudoRandomBytes(pseudoRandomBytes []byte, count int) []Element {
 	// get temporary big int from the pool
 	vv := pool.BigInt.Get()
 
 	res := make([]Element, count)
 	for i := 0; i < count; i++ {
-		vv.SetBytes(pseudoRandomBytes[i*L : (i+1)*L])
+		vv.SetBytes(pseudoRandomBytes[i*hashL : (i+1)*hashL])
 		res[i].SetBigInt(vv)
 	}
 
 	// release object into pool
 	pool.BigInt.Put(vv)
 
-	return res, nil
+	return res
 }
 
 // Exp z = xᵏ (mod q)
@@ -850,6 +905,113 @@ func (z *Element) Exp(x Element, k *big.Int) *Element {
 	return z
 }
 
+// maxFixedExpWindow bounds the width of the sliding window used by FixedExp.
+const maxFixedExpWindow = 6
+
+// FixedExp raises elements to a fixed exponent.
+//
+// The sliding window decomposition of the exponent (an addition chain) is computed once
+// by NewFixedExp, so that repeated exponentiations only perform the squarings and
+// multiplications. A FixedExp is safe for concurrent use.
+type FixedExp struct {
+	window   uint
+	negative bool
+	steps    []fixedExpStep
+}
+
+// fixedExpStep squares the accumulator nbSquares times, then multiplies it by x^digit
+// if digit != 0. digit is odd and smaller than 2^window.
+type fixedExpStep struct {
+	nbSquares uint
+	digit     uint
+}
+
+// NewFixedExp precomputes the addition chain used to raise elements to the given exponent.
+func NewFixedExp(exponent *big.Int) FixedExp {
+	var e big.Int
+	e.Abs(exponent)
+	f := FixedExp{negative: exponent.Sign() == -1}
+
+	// pick the window minimizing the number of multiplications, including the
+	// precomputation of the odd powers x, x³, …, x^(2^w-1)
+	nbBits := e.BitLen()
+	f.window = 1
+	bestCost := nbBits
+	for w := 2; w <= maxFixedExpWindow; w++ {
+		cost := nbBits/(w+1) + (1 << (w - 1))
+		if cost < bestCost {
+			f.window, bestCost = uint(w), cost
+		}
+	}
+
+	// scan the exponent from the most significant bit, each step absorbing the zeros
+	// and the window ending on a set bit that follow the previous one
+	var nbSquares uint
+	for i := nbBits - 1; i >= 0; {
+		if e.Bit(i) == 0 {
+			nbSquares++
+			i--
+			continue
+		}
+		j := max(i-int(f.window)+1, 0)
+		for e.Bit(j) == 0 {
+			j++
+		}
+		var digit uint
+		for k := i; k >= j; k-- {
+			digit = digit<<1 | e.Bit(k)
+		}
+		nbSquares += uint(i - j + 1)
+		if len(f.steps) == 0 {
+			// the accumulator is one, no need to square it
+			nbSquares = 0
+		}
+		f.steps = append(f.steps, fixedExpStep{nbSquares: nbSquares, digit: digit})
+		nbSquares = 0
+		i = j - 1
+	}
+	if nbSquares != 0 {
+		f.steps = append(f.steps, fixedExpStep{nbSquares: nbSquares})
+	}
+
+	return f
+}
+
+// Exp returns xᵏ (mod q) where k is the exponent f was built with.
+func (f FixedExp) Exp(x *Element) Element {
+	var z Element
+	if len(f.steps) == 0 {
+		return *z.SetOne()
+	}
+
+	// table[i] = x^(2i+1)
+	var table [1 << (maxFixedExpWindow - 1)]Element
+	if f.negative {
+		table[0].Inverse(x)
+	} else {
+		table[0].Set(x)
+	}
+	if f.window > 1 {
+		var x2 Element
+		x2.Square(&table[0])
+		for i := 1; i < 1<<(f.window-1); i++ {
+			table[i].Mul(&table[i-1], &x2)
+		}
+	}
+
+	z.Set(&table[f.steps[0].digit>>1])
+	for _, s := range f.steps[1:] {
+		for i := uint(0); i < s.nbSquares; i++ {
+			z.Square(&z)
+		}
+		if s.digit != 0 {
+			z.Mul(&z, &table[s.digit>>1])
+		}
+	}
+
+	return z
+}
+
 // rSquare where r is the Montgommery constant
 // see section 2.3.2 of Tolga Acar's thesis
 // https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
@@ -987,8 +1149,8 @@ func (z *Element) SetBytes(e []byte) *Element {
 }
 
 // SetBytesCanonical interprets e as the bytes of a big-endian 40-byte integer.
-// If e is not a 40-byte slice or encodes a value higher than q,
-// SetBytesCanonical returns an error.
+// If e is not a 40-byte slice or encodes a value greater than or equal to q,
+// SetBytesCanonical returns an error: unlike SetBytes, it does not reduce e modulo q.
 func (z *Element) SetBytesCanonical(e []byte) error {
 	if len(e) != Bytes {
 		return errors.New("invalid fp.Element encoding")
@@ -1031,6 +1193,101 @@ func (z *Element) SetBigInt(v *big.Int) *Element {
 	return z
 }
 
+// SetBigIntConstantTime sets z to v (mod q) and returns z.
+//
+// Unlike SetBigInt, the reduction doesn't branch on the value of v: the words of |v|
+// are accumulated with field operations (Horner's rule) and the sign is applied
+// with a constant-time selection. Only the number of words of v is leaked; this
+// is intended for secret scalars, before a constant-time scalar multiplication.
+func (z *Element) SetBigIntConstantTime(v *big.Int) *Element {
+	const digitBits = 32
+	const digitMask = 1<<digitBits - 1
+
+	var base, acc, d Element
+	base.SetUint64(1 << digitBits)
+
+	words := v.Bits()
+	for i := len(words) - 1; i >= 0; i-- {
+		w := uint64(words[i])
+		for j := bits.UintSize - digitBits; j >= 0; j -= digitBits {
+			d.SetUint64((w >> uint(j)) & digitMask)
+			acc.Mul(&acc, &base).Add(&acc, &d)
+		}
+	}
+
+	// v.Sign() is -1 iff v is negative; the shift maps it to 1 and (0, 1) to 0
+	isNeg := (v.Sign() >> 1) & 1
+	d.Neg(&acc)
+	return z.Select(isNeg, &acc, &d)
+}
+
+// wideRadix is 2⁶⁴ mod q in Montgomery form.
+var wideRadix = func() Element {
+	var r Element
+	r.SetUint64(1 << 32)
+	return *r.Square(&r)
+}()
+
+// ReduceWide sets z to the integer represented by limbs (little-endian, 64-bit
+// limbs, not in Montgomery form) reduced mod q, and returns z in Montgomery form.
+// limbs can be of any length; an empty slice sets z to 0.
+//
+// The limbs are accumulated with Horner's rule without leaving the field: each
+// limb costs one modular multiplication and one addition, and a single
+// conversion to Montgomery form is done at the end, avoiding a big.Int detour.
+func (z *Element) ReduceWide(limbs []uint64) *Element {
+	var acc, l Element
+	for i := len(limbs) - 1; i >= 0; i-- {
+		// since wideRadix is in Montgomery form, acc stays in regular form
+		acc.Mul(&acc, &wideRadix)
+		// q > 2⁶⁴ so a single limb is already reduced
+		l[0] = limbs[i]
+		acc.Add(&acc, &l)
+	}
+	*z = acc
+	return z.toMont()
+}
+
+// Accumulator sums elements without reducing the sum modulo q after each
+// addition, which saves the conditional subtraction of Add in long summations.
+//
+// The sum is kept on 6 words, so that up to 2⁶⁴ elements can be added
+// before calling Reduce. The zero value is an empty sum.
+type Accumulator struct {
+	lo [5]uint64
+	hi uint64
+}
+
+// Add adds e to the sum.
+func (acc *Accumulator) Add(e *Element) {
+	var c uint64
+	acc.lo[0], c = bits.Add64(acc.lo[0], e[0], 0)
+	acc.lo[1], c = bits.Add64(acc.lo[1], e[1], c)
+	acc.lo[2], c = bits.Add64(acc.lo[2], e[2], c)
+	acc.lo[3], c = bits.Add64(acc.lo[3], e[3], c)
+	acc.lo[4], c = bits.Add64(acc.lo[4], e[4], c)
+	acc.hi += c
+}
+
+// Reduce returns the sum of the added elements, reduced modulo q.
+// The accumulator is left unchanged.
+func (acc *Accumulator) Reduce() Element {
+	var limbs [6]uint64
+	copy(limbs[:], acc.lo[:])
+	limbs[5] = acc.hi
+
+	// elements are in Montgomery form, and so is their sum: its reduction
+	// modulo q is the result, as is, which ReduceWide returns in Montgomery form
+	var z Element
+	z.ReduceWide(limbs[:])
+	return *z.fromMont()
+}
+
+// Reset sets the accumulator to the empty sum.
+func (acc *Accumulator) Reset() {
+	*acc = Accumulator{}
+}
+
 // setBigInt assumes 0 ⩽ v < q
 func (z *Element) setBigInt(v *big.Int) *Element {
 	vBits := v.Bits()
@@ -1085,26 +1342,25 @@ func (z *Element) SetString(number string) (*Element, error) {
 	return z, nil
 }
 
-// MarshalJSON returns json encoding of z (z.Text(10))
+// MarshalJSON returns the JSON encoding of z, a 0x-prefixed big-endian hex string
+// of its canonical (non-Montgomery) value, as returned by z.Bytes().
 // If z == nil, returns null
 func (z *Element) MarshalJSON() ([]byte, error) {
 	if z == nil {
 		return []byte("null"), nil
 	}
-	const maxSafeBound = 15 // we encode it as number if it's small
-	s := z.Text(10)
-	if len(s) <= maxSafeBound {
-		return []byte(s), nil
-	}
-	var sbb strings.Builder
-	sbb.WriteByte('"')
-	sbb.WriteString(s)
-	sbb.WriteByte('"')
-	return []byte(sbb.String()), nil
+	b := z.Bytes()
+	res := make([]byte, 0, 2*len(b)+4)
+	res = append(res, `"0x`...)
+	res = hex.AppendEncode(res, b[:])
+	return append(res, '"'), nil
 }
 
 // UnmarshalJSON accepts numbers and strings as input
 // See Element.SetString for valid prefixes (0x, 0b, ...)
+//
+// Unlike SetString, values are not reduced: the absolute value of the input must be
+// strictly smaller than q, so that a negative value -v is interpreted as q - v.
 func (z *Element) UnmarshalJSON(data []byte) error {
 	s := string(data)
 	if len(s) > Bits*3 {
@@ -1123,9 +1379,15 @@ func (z *Element) UnmarshalJSON(data []byte) error {
 	vv := pool.BigInt.Get()
 
 	if _, ok := vv.SetString(s, 0); !ok {
+		pool.BigInt.Put(vv)
 		return errors.New("can't parse into a big.Int: " + s)
 	}
 
+	if vv.CmpAbs(&_modulus) >= 0 {
+		pool.BigInt.Put(vv)
+		return errors.New("value out of range: " + s)
+	}
+
 	z.SetBigInt(vv)
 
 	// release object into pool
@@ -1148,7 +1410,7 @@ var BigEndian bigEndian
 type bigEndian struct{}
 
 // Element interpret b is a big-endian 40-byte slice.
-// If b encodes a value higher than q, Element returns error.
+// If b encodes a value greater than or equal to q, Element returns error.
 func (bigEndian) Element(b *[Bytes]byte) (Element, error) {
 	var z Element
 	z[0] = binary.BigEndian.Uint64((*b)[32:40])
@@ -1347,6 +1609,13 @@ func (z *Element) Legendre() int {
 	}
 }
 
+// IsSquare returns true if z is a square in the field (0 included).
+// It is equivalent to z.Legendre() != -1, and benefits from the same
+// optimized implementation.
+func (z *Element) IsSquare() bool {
+	return z.Legendre() != -1
+}
+
 // approximate a big number x into a single 64 bit word using its uppermost and lowermost bits.
 // If x fits in a word as is, no approximation necessary.
 // This differs from the standard approximate function in that in the Legendre symbol computation
@@ -1377,6 +1646,23 @@ func approximateForLegendre(x *Element, nBits int) uint64 {
 	return lo | mid | hi
 }
 
+// Sqrt2 returns both square roots r1 and r2 = -r1 of x (mod q).
+// r1 is the root that is not lexicographically largest (see LexicographicallyLargest),
+// so that the result is deterministic; z is set to r1 and r2 is newly allocated.
+// If x is not a square mod q, Sqrt2 leaves z unchanged and returns nil, nil, false.
+func (z *Element) Sqrt2(x *Element) (r1, r2 *Element, ok bool) {
+	var r Element
+	if r.Sqrt(x) == nil {
+		return nil, nil, false
+	}
+	if r.LexicographicallyLargest() {
+		r.Neg(&r)
+	}
+	z.Set(&r)
+	r2 = new(Element).Neg(z)
+	return z, r2, true
+}
+
 // Sqrt z = √x (mod q)
 // if the square root doesn't exist (x is not a square mod q)
 // Sqrt leaves z unchanged and returns nil
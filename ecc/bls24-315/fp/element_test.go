@@ -6,14 +6,14 @@
 package fp
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"math/bits"
-
 	mrand "math/rand"
-
 	"testing"
 
 	"github.com/leanovate/gopter"
@@ -21,6 +21,8 @@ import (
 	"github.com/leanovate/gopter/prop"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/field/hash"
 )
 
 // -------------------------------------------------------------------------------------------------
@@ -120,6 +122,17 @@ func BenchmarkElementExp(b *testing.B) {
 	}
 }
 
+func BenchmarkElementFixedExp(b *testing.B) {
+	var x Element
+	x.MustSetRandom()
+	b1, _ := rand.Int(rand.Reader, Modulus())
+	f := NewFixedExp(b1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement = f.Exp(&x)
+	}
+}
+
 func BenchmarkElementDouble(b *testing.B) {
 	benchResElement.MustSetRandom()
 	b.ResetTimer()
@@ -138,6 +151,31 @@ func BenchmarkElementAdd(b *testing.B) {
 	}
 }
 
+func BenchmarkElementAccumulator(b *testing.B) {
+	a := make([]Element, 1<<10)
+	for i := range a {
+		a[i].MustSetRandom()
+	}
+	b.Run("add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var sum Element
+			for j := range a {
+				sum.Add(&sum, &a[j])
+			}
+			benchResElement = sum
+		}
+	})
+	b.Run("accumulator", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var acc Accumulator
+			for j := range a {
+				acc.Add(&a[j])
+			}
+			benchResElement = acc.Reduce()
+		}
+	})
+}
+
 func BenchmarkElementSub(b *testing.B) {
 	var x Element
 	x.MustSetRandom()
@@ -192,6 +230,24 @@ func BenchmarkElementSqrt(b *testing.B) {
 	}
 }
 
+func BenchmarkElementLegendre(b *testing.B) {
+	var a Element
+	a.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = a.Legendre()
+	}
+}
+
+func BenchmarkElementIsSquare(b *testing.B) {
+	var a Element
+	a.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = a.IsSquare()
+	}
+}
+
 func BenchmarkElementMul(b *testing.B) {
 	x := Element{
 		7746605402484284438,
@@ -430,6 +486,57 @@ func TestElementEqual(t *testing.T) {
 	))
 
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// values differing in a single limb
+	var x, y Element
+	x.MustSetRandom()
+	for _, i := range []int{0, 4} {
+		y = x
+		y[i] ^= 1
+		if x.Equal(&y) || x.NotEqual(&y) == 0 {
+			t.Fatalf("values differing only in limb %d should not be equal", i)
+		}
+	}
+}
+
+func TestElementIsZeroConstantTime(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("x.IsZeroConstantTime() must match x.IsZero()", prop.ForAll(
+		func(a testPairElement) bool {
+			return (a.element.IsZeroConstantTime() == 1) == a.element.IsZero()
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var x Element
+	if x.IsZeroConstantTime() != 1 {
+		t.Fatal("IsZeroConstantTime(0) should return 1")
+	}
+	for _, v := range []int64{1, -1, 2, 1 << 32, -(1 << 40)} {
+		x.SetInt64(v)
+		if x.IsZeroConstantTime() != 0 {
+			t.Fatalf("IsZeroConstantTime(%d) should return 0", v)
+		}
+	}
+	// non-zero limbs other than the least significant one
+	x.SetZero()
+	x[4] = 1
+	if x.IsZeroConstantTime() != 0 {
+		t.Fatal("IsZeroConstantTime should return 0 when the most significant limb is set")
+	}
 }
 
 func TestElementBytes(t *testing.T) {
@@ -471,6 +578,39 @@ func TestElementBytes(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestElementSetBytesCanonical(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	encode := func(v *big.Int) []byte {
+		return v.FillBytes(make([]byte, Bytes))
+	}
+	var z, expected Element
+	var v big.Int
+	one := big.NewInt(1)
+
+	// q and q+1 are not canonical, SetBytes would reduce them to 0 and 1
+	assert.Error(z.SetBytesCanonical(encode(Modulus())), "q should be rejected")
+	v.Add(Modulus(), one)
+	assert.Error(z.SetBytesCanonical(encode(&v)), "q+1 should be rejected")
+
+	// q-1 and q-2 are the largest canonical values
+	for _, d := range []int64{1, 2} {
+		v.Sub(Modulus(), big.NewInt(d))
+		assert.NoError(z.SetBytesCanonical(encode(&v)), "q-%d should be accepted", d)
+		expected.SetBigInt(&v)
+		assert.True(z.Equal(&expected), "q-%d decoded incorrectly", d)
+	}
+
+	// 2^(8⋅Bytes) - 1 is not canonical
+	v.Lsh(one, 8*Bytes).Sub(&v, one)
+	assert.Error(z.SetBytesCanonical(encode(&v)))
+
+	// e must be exactly Bytes long
+	assert.Error(z.SetBytesCanonical(make([]byte, Bytes-1)))
+	assert.Error(z.SetBytesCanonical(make([]byte, Bytes+1)))
+}
+
 func TestElementInverseExp(t *testing.T) {
 	// inverse must be equal to exp^-2
 	exp := Modulus()
@@ -621,6 +761,59 @@ func TestElementLegendre(t *testing.T) {
 	require.Equal(t, 0, new(Element).Legendre(), "(0|q) must be zero")
 }
 
+func TestElementIsSquare(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	// find a non-residue, independently of Legendre
+	var nonResidue Element
+	{
+		e := new(big.Int).Rsh(Modulus(), 1) // (q-1)/2
+		var one, minusOne, l Element
+		one.SetOne()
+		minusOne.Neg(&one)
+		nonResidue.SetUint64(2)
+		for !l.Exp(nonResidue, e).Equal(&minusOne) {
+			nonResidue.Add(&nonResidue, &one)
+		}
+	}
+
+	properties.Property("IsSquare should match Legendre() != -1", prop.ForAll(
+		func(a testPairElement) bool {
+			return a.element.IsSquare() == (big.Jacobi(&a.bigint, Modulus()) != -1)
+		},
+		genA,
+	))
+
+	properties.Property("IsSquare should be true on squares and false on non-residues times squares", prop.ForAll(
+		func(a testPairElement) bool {
+			if a.element.IsZero() {
+				return true
+			}
+			var sq, nsq Element
+			sq.Square(&a.element)
+			nsq.Mul(&sq, &nonResidue)
+			return sq.IsSquare() && !nsq.IsSquare()
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	require.True(t, new(Element).IsSquare(), "0 is a square")
+	require.True(t, new(Element).SetOne().IsSquare(), "1 is a square")
+	require.False(t, nonResidue.IsSquare())
+}
+
 func TestElementBitLen(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -711,6 +904,51 @@ func TestElementLexicographicallyLargest(t *testing.T) {
 
 }
 
+func TestElementSqrt2(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("Sqrt2 should return both roots of a square, r2 = -r1 and r1 not lexicographically largest", prop.ForAll(
+		func(a testPairElement) bool {
+			var x, z Element
+			x.Square(&a.element)
+			r1, r2, ok := z.Sqrt2(&x)
+			if !ok || r1 != &z {
+				return false
+			}
+			var s1, s2, sum Element
+			s1.Square(r1)
+			s2.Square(r2)
+			sum.Add(r1, r2)
+			return s1.Equal(&x) && s2.Equal(&x) && sum.IsZero() && !r1.LexicographicallyLargest()
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt2 should fail on non-residues and leave z unchanged", prop.ForAll(
+		func(a testPairElement) bool {
+			if a.element.Legendre() != -1 {
+				return true
+			}
+			z := a.element
+			r1, r2, ok := z.Sqrt2(&a.element)
+			return !ok && r1 == nil && r2 == nil && z.Equal(&a.element)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func TestElementAdd(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -1784,6 +2022,31 @@ func TestElementSelect(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestElementReduceWide(t *testing.T) {
+	t.Parallel()
+
+	var limbs [2*5 + 2]uint64
+	var z, expected Element
+	var v, tmp big.Int
+	for n := 0; n <= len(limbs); n++ {
+		for trial := 0; trial < 20; trial++ {
+			v.SetUint64(0)
+			for i := n - 1; i >= 0; i-- {
+				limbs[i] = mrand.Uint64() //#nosec G404 weak rng is fine here
+				if trial == 0 {
+					limbs[i] = ^uint64(0)
+				}
+				v.Lsh(&v, 64).Add(&v, tmp.SetUint64(limbs[i]))
+			}
+			z.ReduceWide(limbs[:n])
+			expected.SetBigInt(&v)
+			if !z.Equal(&expected) {
+				t.Fatalf("ReduceWide mismatch for %d limbs: %s", n, v.String())
+			}
+		}
+	}
+}
+
 func TestElementSetInt64(t *testing.T) {
 
 	t.Parallel()
@@ -1814,6 +2077,63 @@ func TestElementSetInt64(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestElementSetBigIntConstantTime(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	properties.Property("z.SetBigIntConstantTime must match z.SetBigInt", prop.ForAll(
+		func(a, b testPairElement, neg bool) bool {
+			// a*q+b is larger than q, and a*b*q + b has more words than q
+			var v big.Int
+			var c, d Element
+			vs := []*big.Int{&a.bigint, &b.bigint, new(big.Int), new(big.Int), new(big.Int)}
+			vs[2].Mul(&a.bigint, Modulus()).Add(vs[2], &b.bigint)
+			vs[3].Mul(vs[2], &a.bigint).Add(vs[3], &b.bigint)
+			vs[4].Lsh(&a.bigint, 512)
+			for _, x := range vs {
+				v.Set(x)
+				if neg {
+					v.Neg(&v)
+				}
+				c.SetBigIntConstantTime(&v)
+				d.SetBigInt(&v)
+				if !c.Equal(&d) {
+					return false
+				}
+			}
+			return true
+		},
+		genA, genB, ggen.Bool(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	q := Modulus()
+	var qMinusOne, qPlusOne big.Int
+	qMinusOne.Sub(q, big.NewInt(1))
+	qPlusOne.Add(q, big.NewInt(1))
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(-1), q, new(big.Int).Neg(q), &qMinusOne, &qPlusOne} {
+		var c, d Element
+		c.SetBigIntConstantTime(v)
+		d.SetBigInt(v)
+		if !c.Equal(&d) {
+			t.Fatalf("SetBigIntConstantTime(%s) doesn't match SetBigInt", v.String())
+		}
+	}
+}
+
 func TestElementSetInterface(t *testing.T) {
 
 	t.Parallel()
@@ -2029,6 +2349,73 @@ func TestElementNegativeExp(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestElementNewFixedExp(t *testing.T) {
+	t.Parallel()
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	var qMinusTwo, large big.Int
+	qMinusTwo.Sub(Modulus(), big.NewInt(2))
+	large.Lsh(Modulus(), 3).Add(&large, big.NewInt(7))
+	exponents := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(3),
+		big.NewInt(5),
+		big.NewInt(-1),
+		big.NewInt(-17),
+		new(big.Int).SetUint64(0xffffffffffffffff),
+		&qMinusTwo,
+		&large,
+	}
+	fixedExps := make([]FixedExp, len(exponents))
+	for i := range exponents {
+		fixedExps[i] = NewFixedExp(exponents[i])
+	}
+
+	properties.Property("FixedExp.Exp must match Exp for fixed exponents", prop.ForAll(
+		func(a testPairElement) bool {
+			for i := range exponents {
+				var d Element
+				d.Exp(a.element, exponents[i])
+				c := fixedExps[i].Exp(&a.element)
+				if !c.Equal(&d) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+	))
+
+	properties.Property("FixedExp.Exp must match Exp for random exponents", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var nb big.Int
+			nb.Neg(&b.bigint)
+
+			var d, e Element
+			d.Exp(a.element, &b.bigint)
+			e.Exp(a.element, &nb)
+			c := NewFixedExp(&b.bigint).Exp(&a.element)
+			f := NewFixedExp(&nb).Exp(&a.element)
+			return c.Equal(&d) && f.Equal(&e)
+		},
+		genA, genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func TestElementNewElement(t *testing.T) {
 	assert := require.New(t)
 
@@ -2089,6 +2476,23 @@ func TestElementBatchInvert(t *testing.T) {
 		}
 	}
 
+	// large slices are inverted by chunks, in parallel
+	a := make([]Element, 3*(1<<12)+5)
+	for i := range a {
+		if i%1000 != 0 {
+			a[i].MustSetRandom()
+		}
+	}
+	aInv := BatchInvert(a)
+	for i := range a {
+		var expected Element
+		expected.Inverse(&a[i])
+		assert.True(aInv[i].Equal(&expected), "batchInvert != invert at index %d", i)
+		if !a[i].IsZero() {
+			assert.True(expected.Mul(&expected, &a[i]).IsOne(), "x * x⁻¹ != 1")
+		}
+	}
+
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
 		parameters.MinSuccessfulTests = nbFuzzShort
@@ -2136,6 +2540,39 @@ func TestElementBatchInvert(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestElementAccumulator(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	a := make([]Element, 1<<16)
+	for i := range a {
+		a[i].MustSetRandom()
+	}
+	// q-1 in Montgomery form, the largest representation, for the carries
+	for i := 0; i < 1000; i++ {
+		a[i] = qElement
+		a[i][0]--
+	}
+
+	var acc Accumulator
+	var expected Element
+	for i := range a {
+		acc.Add(&a[i])
+		expected.Add(&expected, &a[i])
+		if i%5000 == 0 {
+			sum := acc.Reduce()
+			assert.True(sum.Equal(&expected), "wrong sum after %d additions", i+1)
+		}
+	}
+	sum := acc.Reduce()
+	assert.True(sum.Equal(&expected), "wrong sum")
+	assert.True(sum.smallerThanModulus(), "sum not reduced")
+
+	acc.Reset()
+	sum = acc.Reduce()
+	assert.True(sum.IsZero())
+}
+
 func TestElementFromMont(t *testing.T) {
 
 	t.Parallel()
@@ -2173,6 +2610,77 @@ func TestElementFromMont(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestElementHash(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	msg := []byte("abc")
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+
+	// reference: reduce L-byte chunks of expand_message_xmd(msg, dst, count·L) modulo q
+	const L = 16 + (Bits+7)/8
+	for _, count := range []int{1, 2, 3} {
+		res, err := Hash(msg, dst, count)
+		assert.NoError(err)
+		assert.Len(res, count)
+
+		uniformBytes, err := hash.ExpandMsgXmd(msg, dst, count*L)
+		assert.NoError(err)
+		for i := range res {
+			var expected big.Int
+			expected.SetBytes(uniformBytes[i*L:(i+1)*L]).Mod(&expected, Modulus())
+			assert.Equal(0, res[i].BigInt(new(big.Int)).Cmp(&expected), "element %d of %d", i, count)
+		}
+	}
+
+	// the domain separation tag matters
+	a, err := Hash(msg, dst, 1)
+	assert.NoError(err)
+	b, err := Hash(msg, []byte("another DST"), 1)
+	assert.NoError(err)
+	assert.False(a[0].Equal(&b[0]))
+}
+
+func TestElementSetRandomFrom(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	const n = 1000
+
+	// same seed, same elements
+	r1 := mrand.New(mrand.NewSource(42)) //#nosec G404 weak rng is fine here
+	r2 := mrand.New(mrand.NewSource(42)) //#nosec G404 weak rng is fine here
+	var x, y Element
+	for i := 0; i < 10; i++ {
+		_, err := x.SetRandomFrom(r1)
+		assert.NoError(err)
+		_, err = y.SetRandomFrom(r2)
+		assert.NoError(err)
+		assert.True(x.Equal(&y), "same seed should yield the same element")
+	}
+
+	// the draws are in range and spread over [0, q)
+	var halfQ, v big.Int
+	halfQ.Rsh(Modulus(), 1)
+	seen := make(map[Element]struct{}, n)
+	upper := 0
+	for i := 0; i < n; i++ {
+		_, err := x.SetRandomFrom(r1)
+		assert.NoError(err)
+		assert.True(x.smallerThanModulus(), "element should be reduced")
+		seen[x] = struct{}{}
+		if x.BigInt(&v).Cmp(&halfQ) > 0 {
+			upper++
+		}
+	}
+	assert.Equal(n, len(seen), "draws should be distinct")
+	assert.InDelta(n/2, upper, n/10, "draws should be spread over [0, q)")
+
+	// reader errors are reported
+	_, err := x.SetRandomFrom(bytes.NewReader(nil))
+	assert.Error(err)
+}
+
 func TestElementJSON(t *testing.T) {
 	assert := require.New(t)
 
@@ -2191,20 +2699,14 @@ func TestElementJSON(t *testing.T) {
 
 	encoded, err := json.Marshal(&s)
 	assert.NoError(err)
-	// we may need to adjust "42" and "8000" values for some moduli; see Text() method for more details.
-	formatValue := func(v int64) string {
-		var a big.Int
-		a.SetInt64(v)
-		a.Mod(&a, Modulus())
-		const maxUint16 = 65535
-		var aNeg big.Int
-		aNeg.Neg(&a).Mod(&aNeg, Modulus())
-		if aNeg.Uint64() != 0 && aNeg.Uint64() <= maxUint16 {
-			return "-" + aNeg.Text(10)
-		}
-		return a.Text(10)
+	// elements are encoded as 0x-prefixed big-endian hex strings of their canonical value
+	hexValue := func(v int64) string {
+		var e Element
+		e.SetInt64(v)
+		b := e.Bytes()
+		return "\"0x" + hex.EncodeToString(b[:]) + "\""
 	}
-	expected := fmt.Sprintf("{\"A\":%s,\"B\":[0,0,%s],\"C\":null,\"D\":%s}", formatValue(-1), formatValue(42), formatValue(8000))
+	expected := fmt.Sprintf("{\"A\":%s,\"B\":[%s,%s,%s],\"C\":null,\"D\":%s}", hexValue(-1), hexValue(0), hexValue(0), hexValue(42), hexValue(8000))
 	assert.Equal(expected, string(encoded))
 
 	// decode valid
@@ -2223,6 +2725,29 @@ func TestElementJSON(t *testing.T) {
 
 	assert.Equal(s, decodedS, " json with strings  -> element  failed")
 
+	// decode canonical hex, as produced by other tooling
+	var a, b Element
+	a.MustSetRandom()
+	err = json.Unmarshal([]byte("\"0x"+a.Text(16)+"\""), &b)
+	assert.NoError(err)
+	assert.True(a.Equal(&b), "hex json -> element failed")
+
+	// round trip of a random element
+	a.MustSetRandom()
+	encoded, err = json.Marshal(&a)
+	assert.NoError(err)
+	err = json.Unmarshal(encoded, &b)
+	assert.NoError(err)
+	assert.True(a.Equal(&b), "element -> json -> element round trip failed")
+
+	// out of range values are rejected
+	var q, q2 big.Int
+	q.Set(Modulus())
+	q2.Neg(&q)
+	for _, v := range []string{"\"0x" + q.Text(16) + "\"", q.Text(10), q2.Text(10)} {
+		err = json.Unmarshal([]byte(v), &b)
+		assert.Error(err, "out of range value %s should be rejected", v)
+	}
 }
 
 type testPairElement struct {
@@ -0,0 +1,118 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package merkle
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	assert := require.New(t)
+
+	var left, right fr.Element
+	left.MustSetRandom()
+	right.MustSetRandom()
+
+	expected, err := defaultPermutation().Compress(left.Marshal(), right.Marshal())
+	assert.NoError(err)
+	res := compress(&left, &right)
+	assert.Equal(expected, res.Marshal())
+}
+
+func TestProof(t *testing.T) {
+	assert := require.New(t)
+
+	const depth = 10
+	tree, err := NewTree(depth)
+	assert.NoError(err)
+
+	leaves := make(map[uint64]fr.Element)
+	for _, index := range []uint64{0, 1, 5, 42, 511, 1023} {
+		var leaf fr.Element
+		leaf.MustSetRandom()
+		tree.Insert(index, leaf)
+		leaves[index] = leaf
+	}
+
+	root := tree.Root()
+	for index, leaf := range leaves {
+		path, err := tree.Proof(index)
+		assert.NoError(err)
+		assert.Len(path, depth)
+		assert.True(VerifyProof(root, leaf, index, path))
+
+		var wrong fr.Element
+		wrong.SetOne().Add(&wrong, &leaf)
+		assert.False(VerifyProof(root, wrong, index, path), "wrong leaf should not verify")
+		assert.False(VerifyProof(root, leaf, index^2, path), "wrong index should not verify")
+		assert.False(VerifyProof(root, leaf, 1<<depth, path), "out of range index should not verify")
+	}
+
+	// empty leaves are zero
+	path, err := tree.Proof(2)
+	assert.NoError(err)
+	assert.True(VerifyProof(root, fr.Element{}, 2, path))
+
+	_, err = tree.Proof(1 << depth)
+	assert.ErrorIs(err, ErrIndexOutOfRange)
+	assert.Panics(func() { tree.Insert(1<<depth, fr.Element{}) })
+}
+
+func TestModifyLeaf(t *testing.T) {
+	assert := require.New(t)
+
+	tree, err := NewTree(MaxDepth)
+	assert.NoError(err)
+	emptyRoot := tree.Root()
+
+	var leaf fr.Element
+	leaf.SetUint64(42)
+	index := uint64(1<<63 + 7)
+	tree.Insert(index, leaf)
+	root := tree.Root()
+	assert.False(root.Equal(&emptyRoot), "inserting a leaf should change the root")
+
+	path, err := tree.Proof(index)
+	assert.NoError(err)
+	assert.True(VerifyProof(root, leaf, index, path))
+
+	leaf.SetUint64(43)
+	tree.Insert(index, leaf)
+	newRoot := tree.Root()
+	assert.False(newRoot.Equal(&root), "modifying a leaf should change the root")
+	assert.True(VerifyProof(newRoot, leaf, index, path), "siblings are unchanged")
+	leaf.SetUint64(42)
+	assert.False(VerifyProof(newRoot, leaf, index, path), "previous leaf should not verify")
+
+	tree.Insert(index, fr.Element{})
+	root = tree.Root()
+	assert.True(root.Equal(&emptyRoot), "resetting the leaf should restore the empty root")
+}
+
+func TestNewTree(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewTree(0)
+	assert.ErrorIs(err, ErrInvalidDepth)
+	_, err = NewTree(MaxDepth + 1)
+	assert.ErrorIs(err, ErrInvalidDepth)
+}
+
+func BenchmarkInsert(b *testing.B) {
+	tree, err := NewTree(32)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var leaf fr.Element
+	leaf.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Insert(uint64(i), leaf)
+	}
+}
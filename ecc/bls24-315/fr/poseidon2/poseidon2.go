@@ -122,9 +122,13 @@ type Permutation struct {
 }
 
 // NewPermutation returns a new Poseidon2 permutation instance.
+//
+// The supported widths are t=2 (2-to-1 compression), t=3 and t=4 (3-to-1
+// compression). The round keys are derived from the seed [Parameters.String],
+// see [NewParameters]. It panics if the width is not supported.
 func NewPermutation(t, rf, rp int) *Permutation {
-	if t < 2 || t > 3 {
-		panic("only t=2,3 is supported")
+	if t < 2 || t > 4 {
+		panic("only t=2,3,4 is supported")
 	}
 	params := NewParameters(t, rf, rp)
 	res := &Permutation{params: params}
@@ -134,8 +138,8 @@ func NewPermutation(t, rf, rp int) *Permutation {
 // NewPermutationWithSeed returns a new Poseidon2 permutation instance with a
 // given seed.
 func NewPermutationWithSeed(t, rf, rp int, seed string) *Permutation {
-	if t < 2 || t > 3 {
-		panic("only t=2,3 is supported")
+	if t < 2 || t > 4 {
+		panic("only t=2,3,4 is supported")
 	}
 	params := NewParametersWithSeed(t, rf, rp, seed)
 	res := &Permutation{params: params}
@@ -162,6 +166,14 @@ func (h *Permutation) sBox(index int, input []fr.Element) {
 
 // when T=2,3 the buffer is multiplied by circ(2,1) and circ(2,1,1)
 // see https://eprint.iacr.org/2023/323.pdf page 15, case T=2,3
+//
+// when T=4 the buffer is multiplied by
+// M4 =
+// (5 7 1 3)
+// (4 6 1 1)
+// (1 3 5 7)
+// (1 1 4 6)
+// see https://eprint.iacr.org/2023/323.pdf page 15 (appendix B for the addition chain)
 func (h *Permutation) matMulExternalInPlace(input []fr.Element) {
 
 	var tmp fr.Element
@@ -176,13 +188,42 @@ func (h *Permutation) matMulExternalInPlace(input []fr.Element) {
 		input[0].Add(&tmp, &input[0])
 		input[1].Add(&tmp, &input[1])
 		input[2].Add(&tmp, &input[2])
+	case 4:
+		var t0, t1, t2, t3, t4, t5, t6, t7 fr.Element
+		t0.Add(&input[0], &input[1])
+		t1.Add(&input[2], &input[3])
+		t2.Double(&input[1]).Add(&t2, &t1)
+		t3.Double(&input[3]).Add(&t3, &t0)
+		t4.Double(&t1).Double(&t4).Add(&t4, &t3)
+		t5.Double(&t0).Double(&t5).Add(&t5, &t2)
+		t6.Add(&t3, &t5)
+		t7.Add(&t2, &t4)
+		input[0].Set(&t6)
+		input[1].Set(&t5)
+		input[2].Set(&t7)
+		input[3].Set(&t4)
 	default:
-		panic("only Width=2,3 are supported")
+		panic("only Width=2,3,4 are supported")
 	}
 }
 
+// diag4 stores the diagonal of M_I-J when T=4, where J is the all-ones matrix.
+// The entries are the smallest distinct integers (in absolute value) such that
+// the characteristic polynomial of M_I^k is irreducible over fr for k=1..8,
+// so that there are no invariant subspace trails through the partial rounds,
+// cf https://eprint.iacr.org/2023/323.pdf section 5.3.
+var diag4 [4]fr.Element
+
+func init() {
+	// diag4 = [1, -1, 2, 3]
+	diag4[0].SetInt64(1)
+	diag4[1].SetInt64(-1)
+	diag4[2].SetInt64(2)
+	diag4[3].SetInt64(3)
+}
+
 // when T=2,3 the matrix are respectibely [[2,1][1,3]] and [[2,1,1][1,2,1][1,1,3]]
-// otherwise the matrix is filled with ones except on the diagonal.
+// when T=4 the matrix is J+diag(diag4), see [diag4].
 func (h *Permutation) matMulInternalInPlace(input []fr.Element) {
 	switch h.params.Width {
 	case 2:
@@ -196,8 +237,14 @@ func (h *Permutation) matMulInternalInPlace(input []fr.Element) {
 		input[0].Add(&input[0], &sum)
 		input[1].Add(&input[1], &sum)
 		input[2].Double(&input[2]).Add(&input[2], &sum)
+	case 4:
+		var sum fr.Element
+		sum.Add(&input[0], &input[1]).Add(&sum, &input[2]).Add(&sum, &input[3])
+		for i := 0; i < 4; i++ {
+			input[i].Mul(&input[i], &diag4[i]).Add(&input[i], &sum)
+		}
 	default:
-		panic("only T=2,3 is supported")
+		panic("only T=2,3,4 is supported")
 	}
 }
 
@@ -63,6 +63,16 @@ func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 	return curve.BatchJacobianToAffineG1(jCoeffs), nil
 }
 
+// fftG1 computes in place the FFT of a, using the twiddles of the domain
+// (see computeTwiddles). Input and output are in natural order.
+func fftG1(a []curve.G1Jac, twiddles []*big.Int) {
+	numCPU := uint64(runtime.NumCPU())
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(numCPU)) << 1
+
+	difFFTG1(a, twiddles, 0, maxSplits, nil)
+	bitReverse(a)
+}
+
 func computeTwiddlesInv(cardinality int) ([]*big.Int, error) {
 	generator, err := fr.Generator(uint64(cardinality))
 	if err != nil {
@@ -72,6 +82,12 @@ func computeTwiddlesInv(cardinality int) ([]*big.Int, error) {
 	// inverse the generator
 	generator.Inverse(&generator)
 
+	return computeTwiddles(generator, cardinality), nil
+}
+
+// computeTwiddles returns the twiddles used by difFFTG1 for a domain of size
+// cardinality generated by generator.
+func computeTwiddles(generator fr.Element, cardinality int) []*big.Int {
 	// nb fft stages
 	nbStages := uint64(bits.TrailingZeros64(uint64(cardinality)))
 
@@ -80,7 +96,7 @@ func computeTwiddlesInv(cardinality int) ([]*big.Int, error) {
 	w := generator
 	r[0] = new(big.Int).SetUint64(1)
 	if len(r) == 1 {
-		return r, nil
+		return r
 	}
 	r[1] = new(big.Int)
 	w.BigInt(r[1])
@@ -90,7 +106,7 @@ func computeTwiddlesInv(cardinality int) ([]*big.Int, error) {
 		w.BigInt(r[j])
 	}
 
-	return r, nil
+	return r
 }
 
 func bitReverse[T any](a []T) {
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"math"
 	"runtime"
+	"slices"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
@@ -29,6 +30,10 @@ func (p *G1Affine) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.
 
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
 //
+// The window size is chosen heuristically, unless config.ChunkSize is one of
+// 4 5 6 7 8 9 10 11 12 13 14 15 16;
+// other values of config.ChunkSize fall back to the heuristic.
+//
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
 func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
 	// TODO @gbotrel replace the ecc.MultiExpConfig by a Option pattern for maintainability.
@@ -71,11 +76,22 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	if config.SparseOptimization {
+		return p.multiExpSparse(points, scalars, config)
+	}
+
+	// implemented msmC methods (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	// a window size set by the caller overrides the heuristic below (and the split)
+	if config.ChunkSize > 0 && slices.Contains(implementedCs, uint64(config.ChunkSize)) {
+		_innerMsmG1(p, uint64(config.ChunkSize), points, scalars, config)
+		return p, nil
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
-		// implemented msmC methods (the c we use must be in this slice)
-		implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
 		var C uint64
 		// approximate cost (in group operations)
 		// cost = bits/c * (nbPoints + 2^{c})
@@ -146,14 +162,56 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 	return p, nil
 }
 
+// multiExpSparse skips the points with a zero scalar and adds directly the
+// points with a scalar equal to one, the bucket method running only on the
+// remaining points (see ecc.MultiExpConfig.SparseOptimization).
+func (p *G1Jac) multiExpSparse(points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	config.SparseOptimization = false
+
+	nbDense := 0
+	for i := range scalars {
+		if !scalars[i].IsZero() && !scalars[i].IsOne() {
+			nbDense++
+		}
+	}
+
+	var ones g1JacExtended
+	ones.SetInfinity()
+	densePoints := make([]G1Affine, 0, nbDense)
+	denseScalars := make([]fr.Element, 0, nbDense)
+	for i := range scalars {
+		switch {
+		case scalars[i].IsZero():
+		case scalars[i].IsOne():
+			ones.addMixed(&points[i])
+		default:
+			densePoints = append(densePoints, points[i])
+			denseScalars = append(denseScalars, scalars[i])
+		}
+	}
+
+	var dense G1Jac
+	if nbDense != 0 {
+		if _, err := dense.MultiExp(densePoints, denseScalars, config); err != nil {
+			return nil, err
+		}
+	}
+	p.fromJacExtended(&ones)
+	p.AddAssign(&dense)
+	return p, nil
+}
+
 func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
 	// partition the scalars
 	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	if config.Stats != nil {
+		recordMSMStats(config.Stats, digits, c, len(scalars))
+	}
 
 	nbChunks := computeNbChunks(c)
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
-	// corresponding bit-window
+	// corresponding bit-window and reduce its buckets into the window's partial sum
 	// note that buckets is an array allocated on the stack and this is critical for performance
 
 	// each go routine sends its result in chChunks[i] channel
@@ -297,7 +355,9 @@ func getChunkProcessorG1(c uint64, stat chunkStat) func(chunkID uint64, chRes ch
 	}
 }
 
-// msmReduceChunkG1Affine reduces the weighted sum of the buckets into the result of the multiExp
+// msmReduceChunkG1Affine combines the partial sums of the windows into the result of the multiExp.
+// The windows are consumed in a fixed order, so the result doesn't depend on the scheduling of the chunk
+// processors.
 func msmReduceChunkG1Affine(p *G1Jac, c int, chChunks []chan g1JacExtended) *G1Jac {
 	var _p g1JacExtended
 	totalj := <-chChunks[len(chChunks)-1]
@@ -313,6 +373,174 @@ func msmReduceChunkG1Affine(p *G1Jac, c int, chChunks []chan g1JacExtended) *G1J
 	return p.unsafeFromJacExtended(&_p)
 }
 
+// MultiExpJacobian is [G1Jac.MultiExp] for points in Jacobian coordinates.
+//
+// The points are normalized once, with a single field inversion for the whole
+// slice (see [BatchJacobianToAffineG1]), so that the caller doesn't
+// need to convert them one by one. The result is the same as converting the
+// points to affine coordinates and calling MultiExp.
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *G1Jac) MultiExpJacobian(points []G1Jac, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	if len(points) != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+	return p.MultiExp(BatchJacobianToAffineG1(points), scalars, config)
+}
+
+// MultiExpBatchG1 computes the multi-exponentiations ∑ᵢ scalars[k][i]⋅points[i] of
+// several scalar vectors sharing the same points; a vector may be shorter than points,
+// in which case only its first len(scalars[k]) points are used.
+//
+// This runs as a single multi-exponentiation rather than len(scalars) independent ones:
+// the scalars are recoded in one pass with a common window size, and the buckets of each
+// window are set up once and reused for all the vectors. The results are normalized with
+// a single field inversion.
+//
+// config.Stats and config.SparseOptimization are ignored.
+//
+// This call return an error if len(scalars[k]) > len(points) or if provided config is invalid.
+func MultiExpBatchG1(points []G1Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G1Affine, error) {
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// offsets[k] is the index of scalars[k] in the concatenation of the vectors
+	offsets := make([]int, len(scalars)+1)
+	for k := range scalars {
+		if len(scalars[k]) > len(points) {
+			return nil, errors.New("len(scalars[k]) > len(points)")
+		}
+		offsets[k+1] = offsets[k] + len(scalars[k])
+	}
+	nbScalars := offsets[len(scalars)]
+	all := make([]fr.Element, 0, nbScalars)
+	for k := range scalars {
+		all = append(all, scalars[k]...)
+	}
+
+	// implemented processors (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	// approximate cost (in group operations), the buckets being reduced once per vector
+	// cost = bits/c * (nbScalars + len(scalars) * 2^{c})
+	var c uint64
+	if config.ChunkSize > 0 && slices.Contains(implementedCs, uint64(config.ChunkSize)) {
+		c = uint64(config.ChunkSize)
+	} else {
+		min := math.MaxFloat64
+		for _, cc := range implementedCs {
+			cost := float64((fr.Bits+1)*(nbScalars+len(scalars)*(1<<cc))) / float64(cc)
+			if cost < min {
+				min = cost
+				c = cc
+			}
+		}
+	}
+
+	digits, chunkStats := partitionScalars(all, c, config.NbTasks)
+	nbChunks := int(computeNbChunks(c))
+
+	// totals[j][k] is the partial sum of the window j for the vector k
+	totals := make([][]g1JacExtended, nbChunks)
+	parallel.Execute(nbChunks, func(start, end int) {
+		for j := start; j < end; j++ {
+			accumulateChunk := getChunkAccumulatorG1(c, chunkStats[j])
+			if j == nbChunks-1 {
+				accumulateChunk = getChunkAccumulatorG1(lastC(c), chunkStats[j])
+			}
+			totals[j] = make([]g1JacExtended, len(scalars))
+			accumulateChunk(points, digits[j*nbScalars:(j+1)*nbScalars], offsets, totals[j])
+		}
+	}, config.NbTasks)
+
+	res := make([]G1Jac, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		for k := start; k < end; k++ {
+			var _p g1JacExtended
+			_p.Set(&totals[nbChunks-1][k])
+			for j := nbChunks - 2; j >= 0; j-- {
+				for l := uint64(0); l < c; l++ {
+					_p.double(&_p)
+				}
+				_p.add(&totals[j][k])
+			}
+			res[k].unsafeFromJacExtended(&_p)
+		}
+	}, config.NbTasks)
+
+	return BatchJacobianToAffineG1(res), nil
+}
+
+// getChunkAccumulatorG1 is getChunkProcessorG1 for the bucket accumulation
+// of several scalar vectors in MultiExpBatchG1.
+func getChunkAccumulatorG1(c uint64, stat chunkStat) func(points []G1Affine, digits []uint16, offsets []int, totals []g1JacExtended) {
+	switch c {
+
+	case 2:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC2]
+	case 4:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC4]
+	case 5:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC5]
+	case 6:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC6]
+	case 7:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC7]
+	case 8:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC8]
+	case 9:
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC9]
+	case 10:
+		const batchSize = 80
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC10]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC10, bucketG1AffineC10, bitSetC10, pG1AffineC10, ppG1AffineC10, qG1AffineC10, cG1AffineC10]
+	case 11:
+		const batchSize = 150
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC11]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC11, bucketG1AffineC11, bitSetC11, pG1AffineC11, ppG1AffineC11, qG1AffineC11, cG1AffineC11]
+	case 12:
+		const batchSize = 200
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC12]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC12, bucketG1AffineC12, bitSetC12, pG1AffineC12, ppG1AffineC12, qG1AffineC12, cG1AffineC12]
+	case 13:
+		const batchSize = 350
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC13]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC13, bucketG1AffineC13, bitSetC13, pG1AffineC13, ppG1AffineC13, qG1AffineC13, cG1AffineC13]
+	case 14:
+		const batchSize = 400
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC14]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC14, bucketG1AffineC14, bitSetC14, pG1AffineC14, ppG1AffineC14, qG1AffineC14, cG1AffineC14]
+	case 15:
+		const batchSize = 500
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC15]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC15, bucketG1AffineC15, bitSetC15, pG1AffineC15, ppG1AffineC15, qG1AffineC15, cG1AffineC15]
+	case 16:
+		const batchSize = 640
+		if stat.nbBucketFilled < batchSize {
+			return accumulateChunkG1Jacobian[bucketg1JacExtendedC16]
+		}
+		return accumulateChunkG1BatchAffine[bucketg1JacExtendedC16, bucketG1AffineC16, bitSetC16, pG1AffineC16, ppG1AffineC16, qG1AffineC16, cG1AffineC16]
+	default:
+		// panic("will not happen c != previous values is not generated by templates")
+		return accumulateChunkG1Jacobian[bucketg1JacExtendedC16]
+	}
+}
+
 // Fold computes the multi-exponentiation \sum_{i=0}^{len(points)-1} points[i] *
 // combinationCoeff^i and stores the result in p. It returns error in case
 // configuration is invalid.
@@ -352,6 +580,10 @@ func (p *G2Affine) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.
 
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
 //
+// The window size is chosen heuristically, unless config.ChunkSize is one of
+// 4 5 6 7 8 9 10 11 12 13 14 15 16;
+// other values of config.ChunkSize fall back to the heuristic.
+//
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
 func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
 	// TODO @gbotrel replace the ecc.MultiExpConfig by a Option pattern for maintainability.
@@ -394,11 +626,22 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	if config.SparseOptimization {
+		return p.multiExpSparse(points, scalars, config)
+	}
+
+	// implemented msmC methods (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	// a window size set by the caller overrides the heuristic below (and the split)
+	if config.ChunkSize > 0 && slices.Contains(implementedCs, uint64(config.ChunkSize)) {
+		_innerMsmG2(p, uint64(config.ChunkSize), points, scalars, config)
+		return p, nil
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
-		// implemented msmC methods (the c we use must be in this slice)
-		implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
 		var C uint64
 		// approximate cost (in group operations)
 		// cost = bits/c * (nbPoints + 2^{c})
@@ -469,14 +712,56 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 	return p, nil
 }
 
+// multiExpSparse skips the points with a zero scalar and adds directly the
+// points with a scalar equal to one, the bucket method running only on the
+// remaining points (see ecc.MultiExpConfig.SparseOptimization).
+func (p *G2Jac) multiExpSparse(points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	config.SparseOptimization = false
+
+	nbDense := 0
+	for i := range scalars {
+		if !scalars[i].IsZero() && !scalars[i].IsOne() {
+			nbDense++
+		}
+	}
+
+	var ones g2JacExtended
+	ones.SetInfinity()
+	densePoints := make([]G2Affine, 0, nbDense)
+	denseScalars := make([]fr.Element, 0, nbDense)
+	for i := range scalars {
+		switch {
+		case scalars[i].IsZero():
+		case scalars[i].IsOne():
+			ones.addMixed(&points[i])
+		default:
+			densePoints = append(densePoints, points[i])
+			denseScalars = append(denseScalars, scalars[i])
+		}
+	}
+
+	var dense G2Jac
+	if nbDense != 0 {
+		if _, err := dense.MultiExp(densePoints, denseScalars, config); err != nil {
+			return nil, err
+		}
+	}
+	p.fromJacExtended(&ones)
+	p.AddAssign(&dense)
+	return p, nil
+}
+
 func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
 	// partition the scalars
 	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	if config.Stats != nil {
+		recordMSMStats(config.Stats, digits, c, len(scalars))
+	}
 
 	nbChunks := computeNbChunks(c)
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
-	// corresponding bit-window
+	// corresponding bit-window and reduce its buckets into the window's partial sum
 	// note that buckets is an array allocated on the stack and this is critical for performance
 
 	// each go routine sends its result in chChunks[i] channel
@@ -620,7 +905,9 @@ func getChunkProcessorG2(c uint64, stat chunkStat) func(chunkID uint64, chRes ch
 	}
 }
 
-// msmReduceChunkG2Affine reduces the weighted sum of the buckets into the result of the multiExp
+// msmReduceChunkG2Affine combines the partial sums of the windows into the result of the multiExp.
+// The windows are consumed in a fixed order, so the result doesn't depend on the scheduling of the chunk
+// processors.
 func msmReduceChunkG2Affine(p *G2Jac, c int, chChunks []chan g2JacExtended) *G2Jac {
 	var _p g2JacExtended
 	totalj := <-chChunks[len(chChunks)-1]
@@ -851,3 +1138,33 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 
 	return digits, chunkStats
 }
+
+// recordMSMStats counts, for each window, the buckets hit by at least one point
+// and the number of points accumulated, and records them in stats.
+func recordMSMStats(stats *ecc.MSMStats, digits []uint16, c uint64, nbScalars int) {
+	nbChunks := computeNbChunks(c)
+	hit := make([]bool, 1<<max(c, lastC(c)))
+	for chunkID := uint64(0); chunkID < nbChunks; chunkID++ {
+		w := ecc.MSMWindowStats{C: c}
+		if chunkID == nbChunks-1 {
+			w.C = lastC(c)
+		}
+		w.NbBuckets = 1 << (w.C - 1)
+		clear(hit)
+		for _, digit := range digits[int(chunkID)*nbScalars : int(chunkID+1)*nbScalars] {
+			if digit == 0 {
+				continue
+			}
+			w.NbAdditions++
+			bucketID := digit >> 1
+			if digit&1 == 0 {
+				bucketID -= 1
+			}
+			if !hit[bucketID] {
+				w.NbBucketsFilled++
+				hit[bucketID] = true
+			}
+		}
+		stats.AddWindow(w)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// FixedBaseTable stores precomputed multiples of a fixed base point to speed up
+// repeated scalar multiplications by this base, e.g. when signing.
+//
+// The scalar is split in windows of windowBits bits and the table stores
+// [j·2^(windowBits·i)]base for each window i and each digit j, so that a scalar
+// multiplication only costs one table lookup and one addition per window,
+// without any doubling. The table holds ⌈b/windowBits⌉·(2^windowBits-1) points,
+// where b is the bit size of the subgroup order.
+//
+// The scalar multiplication is not constant time.
+type FixedBaseTable struct {
+	windowBits int
+	base       PointAffine
+	// table[i][j-1] = [j·2^(windowBits·i)]base
+	table [][]PointAffine
+}
+
+// NewFixedBaseTable precomputes a table of multiples of base, which must be in
+// the prime order subgroup. windowBits must be between 1 and 16; larger windows
+// mean fewer additions per scalar multiplication but exponentially more memory.
+func NewFixedBaseTable(base PointAffine, windowBits int) *FixedBaseTable {
+	if windowBits < 1 || windowBits > 16 {
+		panic("windowBits must be between 1 and 16")
+	}
+	initOnce.Do(initCurveParams)
+	nbWindows := (curveParams.Order.BitLen() + windowBits - 1) / windowBits
+	nbDigits := (1 << windowBits) - 1
+
+	// compute the multiples in extended coordinates
+	points := make([]PointExtended, nbWindows*nbDigits)
+	var windowBase PointExtended
+	windowBase.FromAffine(&base)
+	for i := 0; i < nbWindows; i++ {
+		row := points[i*nbDigits : (i+1)*nbDigits]
+		row[0].Set(&windowBase)
+		for j := 1; j < nbDigits; j++ {
+			row[j].Add(&row[j-1], &windowBase)
+		}
+		// next window base is [2^windowBits]windowBase
+		windowBase.Add(&row[nbDigits-1], &windowBase)
+	}
+
+	// batch convert to affine coordinates
+	zs := make([]fr.Element, len(points))
+	for i := range points {
+		zs[i].Set(&points[i].Z)
+	}
+	zs = fr.BatchInvert(zs)
+
+	t := &FixedBaseTable{windowBits: windowBits, base: base}
+	t.table = make([][]PointAffine, nbWindows)
+	affine := make([]PointAffine, len(points))
+	for i := range points {
+		affine[i].X.Mul(&points[i].X, &zs[i])
+		affine[i].Y.Mul(&points[i].Y, &zs[i])
+	}
+	for i := range t.table {
+		t.table[i] = affine[i*nbDigits : (i+1)*nbDigits]
+	}
+	return t
+}
+
+// Base returns the base point of the table.
+func (t *FixedBaseTable) Base() PointAffine {
+	return t.base
+}
+
+// ScalarMul returns [s]base. The scalar is reduced modulo the subgroup order
+// and may be negative.
+func (t *FixedBaseTable) ScalarMul(s *big.Int) PointAffine {
+	var scalar big.Int
+	scalar.Mod(s, &curveParams.Order)
+
+	var res PointExtended
+	res.setInfinity()
+	for i := range t.table {
+		digit := uint(0)
+		for k := t.windowBits - 1; k >= 0; k-- {
+			digit = digit<<1 | scalar.Bit(i*t.windowBits+k)
+		}
+		if digit != 0 {
+			res.MixedAdd(&res, &t.table[i][digit-1])
+		}
+	}
+
+	var p PointAffine
+	p.FromExtended(&res)
+	return p
+}
+
+// WriteTo writes the table to w: the window size, the compressed base point
+// and the affine coordinates of the precomputed points.
+func (t *FixedBaseTable) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	var header [8]byte
+	binary.BigEndian.PutUint64(header[:], uint64(t.windowBits))
+	written, err := w.Write(header[:])
+	n += int64(written)
+	if err != nil {
+		return n, err
+	}
+	base := t.base.Bytes()
+	written, err = w.Write(base[:])
+	n += int64(written)
+	if err != nil {
+		return n, err
+	}
+	for i := range t.table {
+		for j := range t.table[i] {
+			x, y := t.table[i][j].X.Bytes(), t.table[i][j].Y.Bytes()
+			written, err = w.Write(x[:])
+			n += int64(written)
+			if err != nil {
+				return n, err
+			}
+			written, err = w.Write(y[:])
+			n += int64(written)
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom reads a table written by [FixedBaseTable.WriteTo] from r. It
+// checks that the precomputed points are on the curve and that they are the
+// multiples of the base point: a table with a single wrong entry would give
+// wrong results for some scalars only, which for EdDSA signatures with a
+// deterministic nonce is enough to leak the private key. The check costs one
+// addition per entry.
+func (t *FixedBaseTable) ReadFrom(r io.Reader) (int64, error) {
+	var n int64
+	var header [8]byte
+	read, err := io.ReadFull(r, header[:])
+	n += int64(read)
+	if err != nil {
+		return n, err
+	}
+	windowBits := binary.BigEndian.Uint64(header[:])
+	if windowBits < 1 || windowBits > 16 {
+		return n, errors.New("invalid window size")
+	}
+	t.windowBits = int(windowBits)
+
+	var buf [2 * fr.Bytes]byte
+	read, err = io.ReadFull(r, buf[:sizePointCompressed])
+	n += int64(read)
+	if err != nil {
+		return n, err
+	}
+	if _, err = t.base.SetBytes(buf[:sizePointCompressed]); err != nil {
+		return n, err
+	}
+
+	initOnce.Do(initCurveParams)
+	nbWindows := (curveParams.Order.BitLen() + t.windowBits - 1) / t.windowBits
+	nbDigits := (1 << t.windowBits) - 1
+	affine := make([]PointAffine, nbWindows*nbDigits)
+	t.table = make([][]PointAffine, nbWindows)
+	for i := range t.table {
+		t.table[i] = affine[i*nbDigits : (i+1)*nbDigits]
+		for j := range t.table[i] {
+			read, err = io.ReadFull(r, buf[:])
+			n += int64(read)
+			if err != nil {
+				return n, err
+			}
+			p := &t.table[i][j]
+			if err = p.X.SetBytesCanonical(buf[:fr.Bytes]); err != nil {
+				return n, err
+			}
+			if err = p.Y.SetBytesCanonical(buf[fr.Bytes:]); err != nil {
+				return n, err
+			}
+			if !p.IsOnCurve() {
+				return n, errNotOnCurve
+			}
+		}
+	}
+	if !t.table[0][0].Equal(&t.base) {
+		return n, errors.New("invalid table: first entry doesn't match the base point")
+	}
+	if !t.isConsistent() {
+		return n, errors.New("invalid table: entries are not the multiples of the base point")
+	}
+	return n, nil
+}
+
+// isConsistent checks that table[i][j] = table[i][j-1] + table[i][0] and that
+// table[i+1][0] = [2^windowBits]table[i][0] = table[i][last] + table[i][0], so
+// that table[i][j-1] = [j·2^(windowBits·i)]table[0][0].
+func (t *FixedBaseTable) isConsistent() bool {
+	// e = a, e being in extended coordinates
+	equal := func(e *PointExtended, a *PointAffine) bool {
+		var tmp fr.Element
+		tmp.Mul(&a.X, &e.Z)
+		if !tmp.Equal(&e.X) {
+			return false
+		}
+		tmp.Mul(&a.Y, &e.Z)
+		return tmp.Equal(&e.Y)
+	}
+
+	var e PointExtended
+	for i := range t.table {
+		row := t.table[i]
+		for j := 1; j < len(row); j++ {
+			e.FromAffine(&row[j-1])
+			e.MixedAdd(&e, &row[0])
+			if !equal(&e, &row[j]) {
+				return false
+			}
+		}
+		if i+1 < len(t.table) {
+			e.FromAffine(&row[len(row)-1])
+			e.MixedAdd(&e, &row[0])
+			if !equal(&e, &t.table[i+1][0]) {
+				return false
+			}
+		}
+	}
+	return true
+}
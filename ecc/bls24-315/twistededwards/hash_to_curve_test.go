@@ -0,0 +1,151 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestHashToEdwards(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genElement := func() gopter.Gen {
+		return func(genParams *gopter.GenParameters) *gopter.GenResult {
+			var e fr.Element
+			if _, err := e.SetRandom(); err != nil {
+				panic(err)
+			}
+			return gopter.NewGenResult(e, gopter.NoShrinker)
+		}
+	}
+
+	properties.Property("Elligator 2 should map to the Montgomery curve", prop.ForAll(
+		func(u fr.Element) bool {
+			s, v := mapToMontgomeryElligator2(&u)
+			m := MontgomeryPoint{U: s, V: v}
+			return m.IsOnCurve()
+		},
+		genElement(),
+	))
+
+	properties.Property("Elligator 2 should map u and -u to the same point", prop.ForAll(
+		func(u fr.Element) bool {
+			var uNeg fr.Element
+			uNeg.Neg(&u)
+			s0, v0 := mapToMontgomeryElligator2(&u)
+			s1, v1 := mapToMontgomeryElligator2(&uNeg)
+			return s0.Equal(&s1) && v0.Equal(&v1)
+		},
+		genElement(),
+	))
+
+	properties.Property("mapToEdwards should map to the twisted Edwards curve", prop.ForAll(
+		func(u fr.Element) bool {
+			p := mapToEdwards(&u)
+			return p.IsOnCurve()
+		},
+		genElement(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestHashToEdwardsSubgroup(t *testing.T) {
+	t.Parallel()
+
+	dst := []byte("gnark-crypto-test-bls24-315-twistededwards_XMD:SHA-256_ELL2_RO_")
+	params := GetEdwardsCurve()
+
+	for _, msg := range []string{"", "abc", "abcdef0123456789"} {
+		p, err := HashToEdwards([]byte(msg), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.IsOnCurve() {
+			t.Fatalf("HashToEdwards(%q) is not on the curve", msg)
+		}
+		if p.IsZero() {
+			t.Fatalf("HashToEdwards(%q) is the identity", msg)
+		}
+		var q PointAffine
+		q.ScalarMultiplication(&p, &params.Order)
+		if !q.IsZero() {
+			t.Fatalf("HashToEdwards(%q) is not in the prime order subgroup", msg)
+		}
+
+		// deterministic, and separated by the message and the domain
+		p2, err := HashToEdwards([]byte(msg), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p2.Equal(&p) {
+			t.Fatal("HashToEdwards should be deterministic")
+		}
+		p2, err = HashToEdwards([]byte(msg), []byte("another domain"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p2.Equal(&p) {
+			t.Fatal("HashToEdwards should depend on the domain separation tag")
+		}
+		p2, err = HashToEdwards([]byte(msg+"!"), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p2.Equal(&p) {
+			t.Fatal("HashToEdwards should depend on the message")
+		}
+	}
+}
+
+func TestHashToEdwardsExceptionalInputs(t *testing.T) {
+	t.Parallel()
+
+	// u = 0: x1 = -A/B, which must still give a point on the Montgomery curve
+	var u fr.Element
+	s, v := mapToMontgomeryElligator2(&u)
+	if m := (MontgomeryPoint{U: s, V: v}); !m.IsOnCurve() {
+		t.Fatal("Elligator 2 of 0 should be on the Montgomery curve")
+	}
+
+	// t = 0 and s = -1 have no affine image by the rational map: the identity is returned
+	var one, minusOne fr.Element
+	one.SetOne()
+	minusOne.Neg(&one)
+	for _, c := range []struct{ s, t fr.Element }{
+		{fr.Element{}, fr.Element{}},
+		{one, fr.Element{}},
+		{minusOne, one},
+	} {
+		p := montgomeryToEdwardsRational(&c.s, &c.t)
+		if !p.IsZero() {
+			t.Fatal("the exceptional points of the rational map should be sent to the identity")
+		}
+	}
+}
+
+func BenchmarkHashToEdwards(b *testing.B) {
+	dst := []byte("gnark-crypto-bench-bls24-315-twistededwards_XMD:SHA-256_ELL2_RO_")
+	msg := []byte("abcdef0123456789")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := HashToEdwards(msg, dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
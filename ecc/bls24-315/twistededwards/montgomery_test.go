@@ -0,0 +1,142 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMontgomery(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	params := GetEdwardsCurve()
+
+	properties.Property("ToMontgomery and FromMontgomery should round trip", prop.ForAll(
+		func(s big.Int) bool {
+			var p PointAffine
+			p.ScalarMultiplication(&params.Base, &s)
+
+			m, err := ToMontgomery(p)
+			if err != nil || !m.IsOnCurve() || m.Infinity {
+				return false
+			}
+			q, err := FromMontgomery(m)
+			if err != nil {
+				return false
+			}
+			return q.Equal(&p)
+		},
+		GenBigInt(),
+	))
+
+	properties.Property("ToMontgomery should map -p to -m", prop.ForAll(
+		func(s big.Int) bool {
+			var p, pNeg PointAffine
+			p.ScalarMultiplication(&params.Base, &s)
+			pNeg.Neg(&p)
+
+			m, err := ToMontgomery(p)
+			if err != nil {
+				return false
+			}
+			mNeg, err := ToMontgomery(pNeg)
+			if err != nil {
+				return false
+			}
+			var v fr.Element
+			v.Neg(&m.V)
+			return mNeg.U.Equal(&m.U) && mNeg.V.Equal(&v)
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMontgomeryExceptionalPoints(t *testing.T) {
+	t.Parallel()
+
+	// identity <-> point at infinity
+	var identity PointAffine
+	identity.Y.SetOne()
+	m, err := ToMontgomery(identity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Infinity {
+		t.Fatal("the identity should be mapped to the point at infinity")
+	}
+	p, err := FromMontgomery(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsZero() {
+		t.Fatal("the point at infinity should be mapped to the identity")
+	}
+
+	// (0, -1) <-> (0, 0)
+	var twoTorsion PointAffine
+	twoTorsion.Y.SetOne().Neg(&twoTorsion.Y)
+	m, err = ToMontgomery(twoTorsion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Infinity || !m.U.IsZero() || !m.V.IsZero() || !m.IsOnCurve() {
+		t.Fatal("(0, -1) should be mapped to (0, 0)")
+	}
+	p, err = FromMontgomery(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&twoTorsion) {
+		t.Fatal("(0, 0) should be mapped to (0, -1)")
+	}
+
+	// the other points with v = 0 (if any) and u = -1 have no affine image
+	A, B := MontgomeryCoefficients()
+	var one, delta, u fr.Element
+	one.SetOne()
+	// u² + A⋅u + 1 = 0
+	delta.Square(&A).Sub(&delta, &one).Sub(&delta, &one).Sub(&delta, &one).Sub(&delta, &one)
+	if delta.Sqrt(&delta) != nil {
+		u.Sub(&delta, &A).Halve()
+		if _, err = FromMontgomery(MontgomeryPoint{U: u}); err != ErrExceptionalPoint {
+			t.Fatal("points of order two other than (0, 0) should be rejected")
+		}
+	}
+	// B⋅v² = -1 + A - 1
+	var v fr.Element
+	v.Sub(&A, &one).Sub(&v, &one).Div(&v, &B)
+	if v.Sqrt(&v) != nil {
+		u.Neg(&one)
+		if _, err = FromMontgomery(MontgomeryPoint{U: u, V: v}); err != ErrExceptionalPoint {
+			t.Fatal("points with u = -1 should be rejected")
+		}
+	}
+
+	// points off the curve
+	offCurve := GetEdwardsCurve().Base
+	offCurve.Y.Double(&offCurve.Y)
+	if _, err = ToMontgomery(offCurve); err == nil {
+		t.Fatal("ToMontgomery should reject points off the curve")
+	}
+	if _, err = FromMontgomery(MontgomeryPoint{U: one, V: one}); err == nil {
+		t.Fatal("FromMontgomery should reject points off the curve")
+	}
+}
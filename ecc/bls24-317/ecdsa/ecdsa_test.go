@@ -52,6 +52,28 @@ func TestECDSA(t *testing.T) {
 		},
 	))
 
+	properties.Property("[BLS24-317] test the deterministic (RFC 6979) signing and verification", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig1, err := privKey.SignRFC6979(msg, hFunc)
+			if err != nil {
+				return false
+			}
+			sig2, err := privKey.SignRFC6979(msg, hFunc)
+			if err != nil {
+				return false
+			}
+			flag, _ := publicKey.Verify(sig1.Bytes(), msg, hFunc)
+
+			return flag && sig1 == sig2
+		},
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -0,0 +1,338 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidPolynomialSize   = errors.New("invalid polynomial size (larger than the number of generators)")
+	ErrInvalidProofSize        = errors.New("the number of rounds of the proof doesn't match the number of generators")
+	ErrVerifyOpeningProof      = errors.New("can't verify opening proof")
+	ErrCommitmentNotInSubgroup = errors.New("commitment is not in the correct subgroup")
+	ErrProofNotInSubgroup      = errors.New("proof point is not in the correct subgroup")
+	ErrZeroChallenge           = errors.New("challenge is zero")
+	ErrInvalidPublicParamsSize = errors.New("the number of generators must be a non zero power of two")
+)
+
+// Digest commitment of a polynomial.
+type Digest = curve.G1Affine
+
+// PublicParameters generators used to commit and to prove openings.
+// len(G) is a power of two, and bounds the size of the committed polynomials.
+type PublicParameters struct {
+	G []curve.G1Affine // [G₀, G₁, ..., Gₙ₋₁]
+	U curve.G1Affine   // generator binding the inner product
+}
+
+// OpeningProof IPA proof for opening at a single point.
+type OpeningProof struct {
+	// L, R cross terms of each round of the argument
+	L, R []curve.G1Affine
+
+	// A the polynomial folded down to a single coefficient
+	A fr.Element
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+}
+
+// NewPublicParameters returns size generators (rounded up to the next power of
+// two) and U, obtained by hashing to G1 their index with the domain separation
+// tag dst. Anyone can recompute them from size and dst.
+func NewPublicParameters(size uint64, dst []byte) (*PublicParameters, error) {
+	if size == 0 {
+		return nil, ErrInvalidPublicParamsSize
+	}
+	size = ecc.NextPowerOfTwo(size)
+
+	var pp PublicParameters
+	var err error
+	pp.G = make([]curve.G1Affine, size)
+	msg := make([]byte, 9)
+	msg[0] = 'G'
+	for i := range pp.G {
+		binary.BigEndian.PutUint64(msg[1:], uint64(i))
+		if pp.G[i], err = curve.HashToG1(msg, dst); err != nil {
+			return nil, err
+		}
+	}
+	if pp.U, err = curve.HashToG1([]byte("U"), dst); err != nil {
+		return nil, err
+	}
+	return &pp, nil
+}
+
+// Commit commits to a polynomial using a multi exponentiation with the generators.
+// It is assumed that the polynomial is in canonical form, in Montgomery form.
+func Commit(p []fr.Element, pp *PublicParameters) (Digest, error) {
+	if len(p) > len(pp.G) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+
+	var res Digest
+	if _, err := res.MultiExp(pp.G[:len(p)], p, ecc.MultiExpConfig{}); err != nil {
+		return Digest{}, err
+	}
+	return res, nil
+}
+
+// Open computes an opening proof of polynomial p at given point.
+//
+// Each round splits a = p and b = (1, z, z², ..., zⁿ⁻¹) in halves, sends the
+// cross terms L = ⟨a_lo, G_hi⟩ + [⟨a_lo, b_hi⟩]U' and R = ⟨a_hi, G_lo⟩ + [⟨a_hi, b_lo⟩]U'
+// and folds a' = x·a_lo + x⁻¹·a_hi, b' = x⁻¹·b_lo + x·b_hi, G' = [x⁻¹]G_lo + [x]G_hi,
+// where x is a Fiat Shamir challenge and U' = [ξ]U is bound to the claimed value.
+func Open(p []fr.Element, point fr.Element, pp *PublicParameters) (OpeningProof, error) {
+	nbRounds, err := pp.nbRounds()
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	n := len(pp.G)
+	if len(p) > n {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
+	commitment, err := Commit(p, pp)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	a := make([]fr.Element, n)
+	copy(a, p)
+	b := powers(point, n)
+	g := make([]curve.G1Affine, n)
+	copy(g, pp.G)
+
+	res := OpeningProof{
+		L:            make([]curve.G1Affine, nbRounds),
+		R:            make([]curve.G1Affine, nbRounds),
+		ClaimedValue: innerProduct(a, b),
+	}
+
+	// U' = [ξ]U, ξ being bound to the claimed value
+	fs := newTranscript(nbRounds)
+	xi, err := deriveChallenge(fs, "xi", commitment.Marshal(), point.Marshal(), res.ClaimedValue.Marshal())
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	var u curve.G1Affine
+	var xiInt big.Int
+	u.ScalarMultiplication(&pp.U, xi.BigInt(&xiInt))
+
+	bases := make([]curve.G1Affine, n/2+1)
+	scalars := make([]fr.Element, n/2+1)
+	gJac := make([]curve.G1Jac, n/2)
+	config := ecc.MultiExpConfig{}
+	for j := 0; j < nbRounds; j++ {
+		m := n >> (j + 1)
+		aLo, aHi := a[:m], a[m:2*m]
+		bLo, bHi := b[:m], b[m:2*m]
+		gLo, gHi := g[:m], g[m:2*m]
+
+		// L = ⟨a_lo, G_hi⟩ + [⟨a_lo, b_hi⟩]U'
+		copy(bases, gHi)
+		bases[m] = u
+		copy(scalars, aLo)
+		scalars[m] = innerProduct(aLo, bHi)
+		if _, err := res.L[j].MultiExp(bases[:m+1], scalars[:m+1], config); err != nil {
+			return OpeningProof{}, err
+		}
+
+		// R = ⟨a_hi, G_lo⟩ + [⟨a_hi, b_lo⟩]U'
+		copy(bases, gLo)
+		copy(scalars, aHi)
+		scalars[m] = innerProduct(aHi, bLo)
+		if _, err := res.R[j].MultiExp(bases[:m+1], scalars[:m+1], config); err != nil {
+			return OpeningProof{}, err
+		}
+
+		x, err := deriveRoundChallenge(fs, j, &res.L[j], &res.R[j])
+		if err != nil {
+			return OpeningProof{}, err
+		}
+		var xInv, t fr.Element
+		var xInt, xInvInt big.Int
+		xInv.Inverse(&x)
+		x.BigInt(&xInt)
+		xInv.BigInt(&xInvInt)
+
+		// fold the vectors in place, the low halves hold the result
+		for i := 0; i < m; i++ {
+			t.Mul(&aHi[i], &xInv)
+			aLo[i].Mul(&aLo[i], &x).Add(&aLo[i], &t)
+			t.Mul(&bHi[i], &x)
+			bLo[i].Mul(&bLo[i], &xInv).Add(&bLo[i], &t)
+			gJac[i].JointScalarMultiplication(&gLo[i], &gHi[i], &xInvInt, &xInt)
+		}
+		copy(gLo, curve.BatchJacobianToAffineG1(gJac[:m]))
+	}
+	res.A = a[0]
+
+	return res, nil
+}
+
+// Verify verifies an IPA opening proof at a single point.
+//
+// With Cₖ = C + [v]U' + ∑ⱼ([xⱼ²]Lⱼ + [xⱼ⁻²]Rⱼ), the folded commitment, and s the
+// vector such that the folded generator is ⟨s, G⟩ and the folded b is ⟨s, b⟩,
+// it checks that Cₖ = [a]⟨s, G⟩ + [a⟨s, b⟩]U' with a single multi exponentiation.
+func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, pp *PublicParameters) error {
+	nbRounds, err := pp.nbRounds()
+	if err != nil {
+		return err
+	}
+	n := len(pp.G)
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return ErrInvalidProofSize
+	}
+
+	// check that the commitment and the proof are on the curve
+	if !commitment.IsInSubGroup() {
+		return ErrCommitmentNotInSubgroup
+	}
+	for j := range proof.L {
+		if !proof.L[j].IsInSubGroup() || !proof.R[j].IsInSubGroup() {
+			return ErrProofNotInSubgroup
+		}
+	}
+
+	// replay the transcript
+	fs := newTranscript(nbRounds)
+	xi, err := deriveChallenge(fs, "xi", commitment.Marshal(), point.Marshal(), proof.ClaimedValue.Marshal())
+	if err != nil {
+		return err
+	}
+	x := make([]fr.Element, nbRounds)
+	for j := range x {
+		if x[j], err = deriveRoundChallenge(fs, j, &proof.L[j], &proof.R[j]); err != nil {
+			return err
+		}
+	}
+	xInv := fr.BatchInvert(x)
+
+	// sᵢ = ∏ⱼ xⱼ^(±1), the sign being given by the bit of i folded at round j
+	s := make([]fr.Element, n)
+	s[0].SetOne()
+	for j, l := nbRounds-1, 1; j >= 0; j, l = j-1, l*2 {
+		for i := 0; i < l; i++ {
+			s[i+l].Mul(&s[i], &x[j])
+			s[i].Mul(&s[i], &xInv[j])
+		}
+	}
+
+	// b folded to ⟨s, b⟩
+	bFolded := innerProduct(s, powers(point, n))
+
+	// C + [v]U' + ∑ⱼ([xⱼ²]Lⱼ + [xⱼ⁻²]Rⱼ) - [a]⟨s, G⟩ - [a⟨s, b⟩]U' == 0
+	bases := make([]curve.G1Affine, 0, n+2*nbRounds+2)
+	scalars := make([]fr.Element, 0, n+2*nbRounds+2)
+	bases = append(bases, pp.G...)
+	for i := range s {
+		s[i].Mul(&s[i], &proof.A).Neg(&s[i])
+	}
+	scalars = append(scalars, s...)
+	for j := 0; j < nbRounds; j++ {
+		var x2, xInv2 fr.Element
+		x2.Square(&x[j])
+		xInv2.Square(&xInv[j])
+		bases = append(bases, proof.L[j], proof.R[j])
+		scalars = append(scalars, x2, xInv2)
+	}
+	var uCoeff fr.Element
+	uCoeff.Mul(&proof.A, &bFolded).
+		Sub(&proof.ClaimedValue, &uCoeff).
+		Mul(&uCoeff, &xi)
+	bases = append(bases, *commitment, pp.U)
+	scalars = append(scalars, fr.One(), uCoeff)
+
+	var check curve.G1Affine
+	if _, err := check.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.IsInfinity() {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// nbRounds returns log₂(len(pp.G)), the number of rounds of an opening.
+func (pp *PublicParameters) nbRounds() (int, error) {
+	n := len(pp.G)
+	if n == 0 || n&(n-1) != 0 {
+		return 0, ErrInvalidPublicParamsSize
+	}
+	return bits.TrailingZeros(uint(n)), nil
+}
+
+// newTranscript returns the Fiat Shamir transcript of an opening with nbRounds
+// rounds: ξ, then a challenge per round.
+func newTranscript(nbRounds int) *fiatshamir.Transcript {
+	challenges := make([]string, nbRounds+1)
+	challenges[0] = "xi"
+	for j := 0; j < nbRounds; j++ {
+		challenges[j+1] = roundChallengeID(j)
+	}
+	return fiatshamir.NewTranscript(sha256.New(), challenges...)
+}
+
+func roundChallengeID(j int) string {
+	return "x" + strconv.Itoa(j)
+}
+
+func deriveRoundChallenge(fs *fiatshamir.Transcript, j int, l, r *curve.G1Affine) (fr.Element, error) {
+	return deriveChallenge(fs, roundChallengeID(j), l.Marshal(), r.Marshal())
+}
+
+// deriveChallenge binds the values to the challenge id and computes it. The
+// challenges are inverted, so they must be non zero.
+func deriveChallenge(fs *fiatshamir.Transcript, id string, values ...[]byte) (fr.Element, error) {
+	for _, v := range values {
+		if err := fs.Bind(id, v); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrZeroChallenge
+	}
+	return res, nil
+}
+
+// powers returns [1, x, x², ..., xⁿ⁻¹].
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// innerProduct returns ⟨a, b⟩, len(a) <= len(b).
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
@@ -0,0 +1,193 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/stretchr/testify/require"
+)
+
+const testSize = 64
+
+var testPp *PublicParameters
+
+func init() {
+	var err error
+	testPp, err = NewPublicParameters(testSize, []byte("gnark-crypto IPA test"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomPolynomial(size int) []fr.Element {
+	f := make([]fr.Element, size)
+	for i := range f {
+		f[i].MustSetRandom()
+	}
+	return f
+}
+
+// eval returns p(point) where p is interpreted as a polynomial
+// ∑_{i<len(p)}p[i]Xⁱ
+func eval(p []fr.Element, point fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &point).Add(&res, &p[i])
+	}
+	return res
+}
+
+func TestNewPublicParameters(t *testing.T) {
+	assert := require.New(t)
+
+	pp, err := NewPublicParameters(testSize-10, []byte("gnark-crypto IPA test"))
+	assert.NoError(err)
+	assert.Equal(testSize, len(pp.G), "size should be rounded up to a power of two")
+
+	// the generators are deterministic
+	for i := range pp.G {
+		assert.True(pp.G[i].Equal(&testPp.G[i]))
+	}
+	assert.True(pp.U.Equal(&testPp.U))
+
+	// and depend on the domain separation tag
+	pp, err = NewPublicParameters(testSize, []byte("another tag"))
+	assert.NoError(err)
+	assert.False(pp.G[0].Equal(&testPp.G[0]))
+
+	_, err = NewPublicParameters(0, nil)
+	assert.ErrorIs(err, ErrInvalidPublicParamsSize)
+}
+
+func TestVerify(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{0, 1, 13, testSize} {
+		f := randomPolynomial(size)
+		digest, err := Commit(f, testPp)
+		assert.NoError(err)
+
+		var point fr.Element
+		point.MustSetRandom()
+		proof, err := Open(f, point, testPp)
+		assert.NoError(err)
+
+		// logarithmic proof size
+		assert.Equal(6, len(proof.L))
+		assert.Equal(6, len(proof.R))
+
+		expected := eval(f, point)
+		assert.True(proof.ClaimedValue.Equal(&expected), "inconsistent claimed value")
+		assert.NoError(Verify(&digest, &proof, point, testPp))
+
+		// tampered evaluation
+		proof.ClaimedValue.Add(&proof.ClaimedValue, &point)
+		assert.ErrorIs(Verify(&digest, &proof, point, testPp), ErrVerifyOpeningProof)
+		proof.ClaimedValue = expected
+
+		// other point, constant polynomials excepted
+		if size > 1 {
+			var other fr.Element
+			other.SetOne().Add(&other, &point)
+			assert.ErrorIs(Verify(&digest, &proof, other, testPp), ErrVerifyOpeningProof)
+		}
+	}
+
+	// polynomial larger than the generators
+	_, err := Commit(randomPolynomial(testSize+1), testPp)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Open(randomPolynomial(testSize+1), fr.One(), testPp)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestVerifyTamperedProof(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(testSize)
+	digest, err := Commit(f, testPp)
+	assert.NoError(err)
+	var point fr.Element
+	point.MustSetRandom()
+	proof, err := Open(f, point, testPp)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, testPp))
+
+	_, _, g1Gen, _ := curve.Generators()
+	var one fr.Element
+	one.SetOne()
+
+	for j := range proof.L {
+		// perturb Lⱼ
+		tampered := cloneProof(&proof)
+		tampered.L[j].Add(&tampered.L[j], &g1Gen)
+		assert.ErrorIs(Verify(&digest, &tampered, point, testPp), ErrVerifyOpeningProof, "tampered L[%d]", j)
+
+		// perturb Rⱼ
+		tampered = cloneProof(&proof)
+		tampered.R[j].Add(&tampered.R[j], &g1Gen)
+		assert.ErrorIs(Verify(&digest, &tampered, point, testPp), ErrVerifyOpeningProof, "tampered R[%d]", j)
+
+		// swap Lⱼ and Rⱼ
+		tampered = cloneProof(&proof)
+		tampered.L[j], tampered.R[j] = tampered.R[j], tampered.L[j]
+		assert.ErrorIs(Verify(&digest, &tampered, point, testPp), ErrVerifyOpeningProof, "swapped L[%d], R[%d]", j, j)
+	}
+
+	// perturb the final scalar
+	tampered := cloneProof(&proof)
+	tampered.A.Add(&tampered.A, &one)
+	assert.ErrorIs(Verify(&digest, &tampered, point, testPp), ErrVerifyOpeningProof)
+
+	// truncated proof
+	tampered = cloneProof(&proof)
+	tampered.L, tampered.R = tampered.L[1:], tampered.R[1:]
+	assert.ErrorIs(Verify(&digest, &tampered, point, testPp), ErrInvalidProofSize)
+
+	// wrong commitment
+	var wrongDigest Digest
+	wrongDigest.Add(&digest, &g1Gen)
+	assert.ErrorIs(Verify(&wrongDigest, &proof, point, testPp), ErrVerifyOpeningProof)
+}
+
+func cloneProof(proof *OpeningProof) OpeningProof {
+	res := *proof
+	res.L = append([]curve.G1Affine(nil), proof.L...)
+	res.R = append([]curve.G1Affine(nil), proof.R...)
+	return res
+}
+
+func BenchmarkOpen(b *testing.B) {
+	f := randomPolynomial(testSize)
+	var point fr.Element
+	point.MustSetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Open(f, point, testPp)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	f := randomPolynomial(testSize)
+	var point fr.Element
+	point.MustSetRandom()
+	digest, err := Commit(f, testPp)
+	if err != nil {
+		b.Fatal(err)
+	}
+	proof, err := Open(f, point, testPp)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&digest, &proof, point, testPp)
+	}
+}
@@ -6,6 +6,8 @@
 package bls24317
 
 import (
+	"io"
+
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/hash_to_curve"
 )
@@ -19,6 +21,17 @@ func MapToG1(u fp.Element) G1Affine {
 	return res
 }
 
+// MapToCurveG1 is the map_to_curve step of RFC 9380: it maps u to a point on the curve using the
+// SSWU map followed by the isogeny to the target curve.
+// The result is not guaranteed to be in G1; use [MapToG1] to also clear the cofactor.
+//
+// See https://www.rfc-editor.org/rfc/rfc9380.html#section-6
+func MapToCurveG1(u *fp.Element) G1Affine {
+	res := MapToCurve1(u)
+	hash_to_curve.G1Isogeny(&res.X, &res.Y)
+	return res
+}
+
 // EncodeToG1 hashes a message to a point on the G1 curve using the SSWU map.
 // It is faster than [HashToG1], but the result is not uniformly distributed. Unsuitable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
@@ -50,7 +63,24 @@ func HashToG1(msg, dst []byte) (G1Affine, error) {
 	if err != nil {
 		return G1Affine{}, err
 	}
+	return hashToG1(u), nil
+}
+
+// HashToG1Reader is [HashToG1] for a message read from r until io.EOF.
+//
+// The message is streamed through expand_message_xmd and never held in memory, so that it can be
+// arbitrarily large. As in [HashToG1], domain separation tags longer than 255 bytes are
+// first hashed down as specified in RFC 9380 section 5.3.3.
+func HashToG1Reader(r io.Reader, dst []byte) (G1Affine, error) {
+	u, err := fp.HashReader(r, dst, 2*1)
+	if err != nil {
+		return G1Affine{}, err
+	}
+	return hashToG1(u), nil
+}
 
+// hashToG1 maps the 2 field elements u obtained by hash_to_field to a point in G1.
+func hashToG1(u []fp.Element) G1Affine {
 	Q0 := MapToCurve1(&u[0])
 	Q1 := MapToCurve1(&u[1])
 
@@ -65,7 +95,7 @@ func HashToG1(msg, dst []byte) (G1Affine, error) {
 	_Q1.ClearCofactor(&_Q1)
 
 	Q1.FromJacobian(&_Q1)
-	return Q1, nil
+	return Q1
 }
 
 // MapToCurve1 implements the SSWU map. It does not perform cofactor clearing nor isogeny. For map to group, use [MapToG1].
@@ -8,8 +8,11 @@ package bls24317
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
 
+	"bytes"
 	"math/rand"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/hash_to_curve"
 	"github.com/leanovate/gopter"
@@ -75,6 +78,8 @@ func TestMapToCurve1(t *testing.T) {
 		q := MapToCurve1(&u)
 		hash_to_curve.G1Isogeny(&q.X, &q.Y)
 		g1TestMatchPoint(t, "Q", c.msg, c.Q, &q)
+		q = MapToCurveG1(&u)
+		g1TestMatchPoint(t, "Q", c.msg, c.Q, &q)
 	}
 
 	for _, c := range hashToG1Vector.cases {
@@ -110,6 +115,24 @@ func TestMapToG1(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G1] MapToCurveG1 should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG1(&a)
+			return g.IsOnCurve()
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] MapToG1 should be MapToCurveG1 followed by cofactor clearing", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG1(&a)
+			g.ClearCofactor(&g)
+			h := MapToG1(a)
+			return g.Equal(&h)
+		},
+		GenFp(),
+	))
+
 	properties.Property("[G1] mapping to curve should be deterministic", prop.ForAll(
 		func(a fp.Element) bool {
 			g1 := MapToG1(a)
@@ -144,6 +167,33 @@ func TestHashToG1(t *testing.T) {
 	}
 }
 
+func TestHashToG1Reader(t *testing.T) {
+	t.Parallel()
+	for _, c := range hashToG1Vector.cases {
+		p, err := HashToG1Reader(strings.NewReader(c.msg), hashToG1Vector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g1TestMatchPoint(t, "P", c.msg, c.P, &p)
+	}
+
+	// large message read in small chunks, with a DST longer than 255 bytes
+	msg := bytes.Repeat([]byte("gnark-crypto"), 1<<17)
+	for _, dst := range [][]byte{hashToG1Vector.dst, bytes.Repeat([]byte("long-DST-"), 50)} {
+		expected, err := HashToG1(msg, dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := HashToG1Reader(iotest.HalfReader(bytes.NewReader(msg)), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&expected) {
+			t.Fatalf("streamed hash (dst length %d) differs from HashToG1", len(dst))
+		}
+	}
+}
+
 func BenchmarkEncodeToG1(b *testing.B) {
 	const size = 54
 	bytes := make([]byte, size)
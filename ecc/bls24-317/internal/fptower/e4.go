@@ -60,6 +60,14 @@ func (z *E4) Set(x *E4) *E4 {
 	return z
 }
 
+// Select is conditional move.
+// If cond = 0, it sets z to caseZ and returns it. otherwise caseNz.
+func (z *E4) Select(cond int, caseZ *E4, caseNz *E4) *E4 {
+	z.B0.Select(cond, &caseZ.B0, &caseNz.B0)
+	z.B1.Select(cond, &caseZ.B1, &caseNz.B1)
+	return z
+}
+
 // SetZero sets an E4 elmt to zero
 func (z *E4) SetZero() *E4 {
 	z.B0.SetZero()
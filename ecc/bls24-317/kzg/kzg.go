@@ -6,14 +6,17 @@
 package kzg
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"hash"
 	"math/big"
 	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc"
-	bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
 	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
 
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -26,8 +29,15 @@ var (
 	ErrVerifyOpeningProof            = errors.New("can't verify opening proof")
 	ErrVerifyBatchOpeningSinglePoint = errors.New("can't verify batch opening proof at single point")
 	ErrMinSRSSize                    = errors.New("minimum srs size is 2")
+	ErrNotEnoughPoints               = errors.New("the proving key holds fewer points than requested")
+	ErrInvalidNbPoints               = errors.New("number of points is zero or not the same as the number of claimed values")
+	ErrDuplicatePoint                = errors.New("opening points must be distinct")
 	ErrCommitmentNotInSubgroup       = errors.New("commitment is not in the correct subgroup")
 	ErrQuotientNotNotInSubgroup      = errors.New("proof quotient is not in the correct subgroup")
+	ErrInvalidHeader                 = errors.New("invalid header: not a KZG encoding")
+	ErrUnsupportedVersion            = errors.New("unsupported encoding version")
+	ErrCurveMismatch                 = errors.New("encoding is for another curve")
+	ErrCommitmentHashMismatch        = errors.New("commitment does not match the commitment hash")
 )
 
 // Digest commitment of a polynomial.
@@ -38,6 +48,12 @@ type ProvingKey struct {
 	G1 []bls24317.G1Affine // [G₁ [α]G₁ , [α²]G₁, ... ]
 }
 
+// ProvingKeyLagrange used to create commitments of polynomials given by their
+// evaluations on a domain
+type ProvingKeyLagrange struct {
+	G1 []bls24317.G1Affine // [L₀(α)]G₁, [L₁(α)]G₁, ... where Lᵢ are the Lagrange polynomials of the domain
+}
+
 // VerifyingKey used to verify opening proofs
 type VerifyingKey struct {
 	G2    [2]bls24317.G2Affine // [G₂, [α]G₂ ]
@@ -133,6 +149,25 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	return &srs, nil
 }
 
+// srsSeedDST is the domain separation tag used to derive α in NewSRSFromSeed.
+const srsSeedDST = "gnark-crypto KZG INSECURE TEST SRS"
+
+// NewSRSFromSeed returns a new SRS whose secret α is derived deterministically
+// from seed, by hashing it to fr (see fr.Hash).
+//
+// WARNING: this is for testing only. Anyone knowing the seed knows α and can
+// forge opening proofs; an SRS generated this way MUST NOT be used in production.
+// In production, a SRS generated through MPC should be used.
+func NewSRSFromSeed(size uint64, seed []byte) (*SRS, error) {
+	alpha, err := fr.Hash(seed, []byte(srsSeedDST), 1)
+	if err != nil {
+		return nil, err
+	}
+	var bAlpha big.Int
+	alpha[0].BigInt(&bAlpha)
+	return NewSRS(size, &bAlpha)
+}
+
 // OpeningProof KZG proof for opening at a single point.
 //
 // implements io.ReaderFrom and io.WriterTo
@@ -144,6 +179,22 @@ type OpeningProof struct {
 	ClaimedValue fr.Element
 }
 
+// MultiPointProof opening proof for a polynomial at several distinct points
+//
+// implements io.ReaderFrom and io.WriterTo
+type MultiPointProof struct {
+	// H quotient polynomial (f - I)/Z, where Z = ∏ᵢ(X-zᵢ) is the vanishing polynomial
+	// of the points and I the polynomial interpolating the claimed values
+	H bls24317.G1Affine
+
+	// HFolded quotient polynomial (L - L(γ))/(X-γ) where L = f - Z(γ)(f - I)/Z
+	// and γ is a Fiat Shamir challenge
+	HFolded bls24317.G1Affine
+
+	// ClaimedValues purported values, ClaimedValues[i] = f(zᵢ)
+	ClaimedValues []fr.Element
+}
+
 // BatchOpeningProof opening proof for many polynomials at the same point
 //
 // implements io.ReaderFrom and io.WriterTo
@@ -176,6 +227,155 @@ func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
 	return res, nil
 }
 
+// CommitConstant commits to the constant polynomial c. It is the same as
+// Commit([]fr.Element{c}, pk), using a single scalar multiplication of the
+// first SRS point instead of a multi exponentiation.
+func CommitConstant(c fr.Element, pk ProvingKey) (Digest, error) {
+	if len(pk.G1) == 0 {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+
+	var res bls24317.G1Affine
+	var b big.Int
+	res.ScalarMultiplication(&pk.G1[0], c.BigInt(&b))
+
+	return res, nil
+}
+
+// CommitBatch commits to a list of polynomials sharing the SRS basis.
+// The commitments are computed by a single multi exponentiation over the basis
+// (see bls24317.MultiExpBatchG1), the scalars being recoded once and the buckets of each
+// window being reused for all the polynomials. The result is the same as calling
+// Commit on each polynomial.
+// It is assumed that the polynomials are in canonical form, in Montgomery form.
+func CommitBatch(polys [][]fr.Element, pk ProvingKey, nbTasks ...int) ([]Digest, error) {
+	for _, p := range polys {
+		if len(p) > len(pk.G1) {
+			return nil, ErrInvalidPolynomialSize
+		}
+	}
+	if len(polys) == 0 {
+		return nil, nil
+	}
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+
+	return bls24317.MultiExpBatchG1(pk.G1, polys, config)
+}
+
+// NewProvingKeyLagrange returns a proving key holding the SRS in the Lagrange basis
+// of the domain, such that commitments can be computed directly from evaluations
+// (see CommitLagrange).
+func NewProvingKeyLagrange(srs *SRS, domain *fft.Domain) (ProvingKeyLagrange, error) {
+	if domain.Cardinality > uint64(len(srs.Pk.G1)) {
+		return ProvingKeyLagrange{}, ErrInvalidPolynomialSize
+	}
+	g1, err := ToLagrangeG1(srs.Pk.G1[:domain.Cardinality])
+	if err != nil {
+		return ProvingKeyLagrange{}, err
+	}
+	return ProvingKeyLagrange{G1: g1}, nil
+}
+
+// CommitLagrange commits to a polynomial given by its evaluations on the domain
+// used to build pk, using a multi exponentiation with the Lagrange SRS.
+// It is assumed that the evaluations are in regular layout, in Montgomery form;
+// the result is the same as Commit on the canonical form of the polynomial.
+func CommitLagrange(evals []fr.Element, pk ProvingKeyLagrange, nbTasks ...int) (Digest, error) {
+	if len(evals) != len(pk.G1) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+
+	var res bls24317.G1Affine
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(pk.G1, evals, config); err != nil {
+		return Digest{}, err
+	}
+
+	return res, nil
+}
+
+// OpenLagrange computes an opening proof at point of the polynomial given by its
+// evaluations on the domain used to build pk, in regular layout (see CommitLagrange).
+// No FFT is done: the claimed value is computed with the barycentric formula
+//
+//	p(z) = (zⁿ - 1)/n ⋅ ∑ᵢ pᵢωⁱ/(z - ωⁱ)
+//
+// and the quotient H = (p - p(z))/(X - z) is built in evaluation form,
+// Hᵢ = (pᵢ - p(z))/(ωⁱ - z), then committed with pk. When z = ωʲ is in the
+// domain, p(z) = pⱼ and Hⱼ = -∑_{i≠j}Hᵢωⁱ⁻ʲ, as ∑ᵢHᵢωⁱ = 0 for deg H < n-1.
+//
+// The proof is the same as the one of Open on the canonical form of the polynomial.
+func OpenLagrange(evals []fr.Element, domain *fft.Domain, point fr.Element, pk ProvingKeyLagrange) (OpeningProof, error) {
+	n := len(evals)
+	if n == 0 || uint64(n) != domain.Cardinality || n != len(pk.G1) {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
+
+	// ωⁱ, then ωⁱ - z
+	omegas := make([]fr.Element, n)
+	omegas[0].SetOne()
+	for i := 1; i < n; i++ {
+		omegas[i].Mul(&omegas[i-1], &domain.Generator)
+	}
+	denominators := make([]fr.Element, n)
+	inDomain := -1
+	for i := range denominators {
+		denominators[i].Sub(&omegas[i], &point)
+		if denominators[i].IsZero() {
+			inDomain = i
+		}
+	}
+	// 1/(ωⁱ - z), 0 for ωⁱ = z
+	denominators = fr.BatchInvert(denominators)
+
+	var res OpeningProof
+	if inDomain >= 0 {
+		res.ClaimedValue = evals[inDomain]
+	} else {
+		// p(z) = (zⁿ - 1)/n ⋅ ∑ᵢ pᵢωⁱ/(z - ωⁱ) = -(zⁿ - 1)/n ⋅ ∑ᵢ pᵢωⁱ/(ωⁱ - z)
+		var t fr.Element
+		for i := range evals {
+			t.Mul(&evals[i], &omegas[i]).Mul(&t, &denominators[i])
+			res.ClaimedValue.Add(&res.ClaimedValue, &t)
+		}
+		t = domain.EvaluateVanishing(point)
+		t.Mul(&t, &domain.CardinalityInv)
+		res.ClaimedValue.Mul(&res.ClaimedValue, &t).Neg(&res.ClaimedValue)
+	}
+
+	// Hᵢ = (pᵢ - p(z))/(ωⁱ - z)
+	h := make([]fr.Element, n)
+	for i := range h {
+		h[i].Sub(&evals[i], &res.ClaimedValue).Mul(&h[i], &denominators[i])
+	}
+	if inDomain >= 0 {
+		// Hⱼ = -∑_{i≠j}Hᵢωⁱ⁻ʲ = -ω⁻ʲ∑_{i≠j}Hᵢωⁱ, Hⱼ being 0 at this point
+		var t, sum fr.Element
+		for i := range h {
+			t.Mul(&h[i], &omegas[i])
+			sum.Add(&sum, &t)
+		}
+		// ω⁻ʲ = ωⁿ⁻ʲ
+		h[inDomain].Mul(&sum, &omegas[(n-inDomain)%n]).Neg(&h[inDomain])
+	}
+
+	hCommit, err := CommitLagrange(h, pk)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	res.H.Set(&hCommit)
+
+	return res, nil
+}
+
 // Open computes an opening proof of polynomial p at given point.
 // fft.Domain Cardinality must be larger than p.Degree()
 func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error) {
@@ -184,15 +384,11 @@ func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error)
 	}
 
 	// build the proof
-	res := OpeningProof{
-		ClaimedValue: eval(p, point),
-	}
+	var res OpeningProof
 
 	// compute H
-	// h reuses memory from _p
-	_p := make([]fr.Element, len(p))
-	copy(_p, p)
-	h := dividePolyByXminusA(_p, res.ClaimedValue, point)
+	h, value := ComputeQuotient(p, point)
+	res.ClaimedValue = value
 
 	// commit to H
 	hCommit, err := Commit(h, pk)
@@ -204,6 +400,96 @@ func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error)
 	return res, nil
 }
 
+// ComputeQuotient returns the coefficients of the quotient polynomial
+// (p - p(z))/(X - z) committed to by Open, and the evaluation p(z).
+// The quotient has len(p)-1 coefficients (none if len(p) ⩽ 1), and p is not
+// modified.
+func ComputeQuotient(p []fr.Element, point fr.Element) (quotient []fr.Element, value fr.Element) {
+	value = eval(p, point)
+
+	// the quotient reuses memory from _p
+	_p := make([]fr.Element, len(p))
+	copy(_p, p)
+	if len(_p) == 0 {
+		return _p, value
+	}
+	quotient = dividePolyByXminusA(_p, value, point)
+
+	return quotient, value
+}
+
+// OpenAllPoints computes the opening proofs of polynomial p at every point of the domain,
+// using the Feist–Khovratovich algorithm (https://eprint.iacr.org/2023/033.pdf).
+// The i-th proof is the opening at ωⁱ where ω is the domain generator.
+//
+// Writing p = ∑_{j⩽d}pⱼXʲ, the quotient (p-p(ωⁱ))/(X-ωⁱ) commits to ∑_{k<d}hₖωⁱᵏ
+// where hₖ = ∑_{j=k+1}^{d}pⱼ[αʲ⁻ᵏ⁻¹]G₁. The hₖ are obtained with a Toeplitz matrix-vector
+// product (computed with FFTs over G₁) and the proofs with a final FFT over G₁, so that
+// the cost is O(n log n) group operations instead of n multi-exponentiations.
+//
+// p must be in canonical form and its size must not exceed the domain cardinality.
+func OpenAllPoints(p []fr.Element, domain *fft.Domain, pk ProvingKey) ([]OpeningProof, error) {
+	n := int(domain.Cardinality)
+	if len(p) == 0 || len(p) > n || len(p) > len(pk.G1) {
+		return nil, ErrInvalidPolynomialSize
+	}
+
+	proofs := make([]OpeningProof, n)
+
+	// claimed values: p evaluated on the domain
+	evals := make([]fr.Element, n)
+	copy(evals, p)
+	domain.FFT(evals, fft.DIF)
+	fft.BitReverse(evals)
+	for i := range proofs {
+		proofs[i].ClaimedValue = evals[i]
+	}
+
+	d := len(p) - 1
+	if d == 0 {
+		// constant polynomial, all the quotients are zero
+		return proofs, nil
+	}
+
+	// hₖ is the coefficient d+k of the product of ∑_{j⩽d}pⱼXʲ and ∑_{t<d}[αᵈ⁻¹⁻ᵗ]G₁Xᵗ,
+	// which we compute as a cyclic convolution of size m ⩾ 2d.
+	m := int(ecc.NextPowerOfTwo(uint64(2 * d)))
+	convDomain := fft.NewDomain(uint64(m))
+
+	a := make([]fr.Element, m)
+	copy(a, p)
+	convDomain.FFT(a, fft.DIF)
+	fft.BitReverse(a)
+
+	b := make([]bls24317.G1Jac, m)
+	for t := 0; t < d; t++ {
+		b[t].FromAffine(&pk.G1[d-1-t])
+	}
+	fftG1(b, computeTwiddles(convDomain.Generator, m))
+
+	// pointwise product, the 1/m factor of the inverse FFT is applied here
+	parallel.Execute(m, func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &convDomain.CardinalityInv).BigInt(&s)
+			b[i].ScalarMultiplication(&b[i], &s)
+		}
+	})
+	fftG1(b, computeTwiddles(convDomain.GeneratorInv, m))
+
+	// proofs: [qᵢ]G₁ = ∑_{k<d}hₖωⁱᵏ
+	h := make([]bls24317.G1Jac, n)
+	copy(h, b[d:2*d])
+	fftG1(h, computeTwiddles(domain.Generator, n))
+
+	hAff := bls24317.BatchJacobianToAffineG1(h)
+	for i := range proofs {
+		proofs[i].H = hAff[i]
+	}
+
+	return proofs, nil
+}
+
 // Verify verifies a KZG opening proof at a single point
 func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, vk VerifyingKey) error {
 
@@ -245,6 +531,316 @@ func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, vk Verify
 	return nil
 }
 
+// HashCommitment returns the fingerprint of a commitment checked by
+// VerifyWithCommitmentHash: hf applied to the compressed encoding of the
+// commitment. hf is reset before use.
+func HashCommitment(commitment *Digest, hf hash.Hash) []byte {
+	hf.Reset()
+	b := commitment.Bytes()
+	hf.Write(b[:])
+	return hf.Sum(nil)
+}
+
+// VerifyWithCommitmentHash verifies a KZG opening proof at a single point for a
+// verifier that only holds commitmentHash, a fingerprint of the commitment
+// (see HashCommitment), the commitment itself being sent along the proof.
+//
+// The commitment is hashed and compared to commitmentHash first, so that a
+// mismatch is rejected with ErrCommitmentHashMismatch before any subgroup or
+// pairing check. Verify is then called as usual.
+func VerifyWithCommitmentHash(commitment *Digest, proof *OpeningProof, commitmentHash []byte, point fr.Element, vk VerifyingKey, hf hash.Hash) error {
+	if !bytes.Equal(HashCommitment(commitment, hf), commitmentHash) {
+		return ErrCommitmentHashMismatch
+	}
+	return Verify(commitment, proof, point, vk)
+}
+
+// CommitChunked commits to a polynomial which may be larger than the SRS, by
+// splitting it into chunks of n = len(pk.G1) coefficients, p = ∑ᵢXⁿⁱpᵢ, and
+// committing to each chunk pᵢ (see CommitBatch).
+// It is assumed that the polynomial is in canonical form, in Montgomery form.
+func CommitChunked(p []fr.Element, pk ProvingKey, nbTasks ...int) ([]Digest, error) {
+	if len(p) == 0 || len(pk.G1) == 0 {
+		return nil, ErrInvalidPolynomialSize
+	}
+	return CommitBatch(splitInChunks(p, len(pk.G1)), pk, nbTasks...)
+}
+
+// OpenChunked computes the opening proofs at point of the chunks pᵢ of p, as
+// committed by CommitChunked.
+func OpenChunked(p []fr.Element, point fr.Element, pk ProvingKey) ([]OpeningProof, error) {
+	if len(p) == 0 || len(pk.G1) == 0 {
+		return nil, ErrInvalidPolynomialSize
+	}
+	chunks := splitInChunks(p, len(pk.G1))
+	proofs := make([]OpeningProof, len(chunks))
+	for i := range chunks {
+		var err error
+		if proofs[i], err = Open(chunks[i], point, pk); err != nil {
+			return nil, err
+		}
+	}
+	return proofs, nil
+}
+
+// VerifyChunked verifies the openings at point of the chunks of a polynomial
+// committed with CommitChunked, n = chunkSize being the size of the SRS used to
+// commit, and returns the evaluation p(point) = ∑ᵢpointⁿⁱpᵢ(point).
+//
+// The digests, quotients and claimed values are combined with the powers of
+// pointⁿ into a single opening at point of ∑ᵢpointⁿⁱpᵢ, checked with Verify.
+// This proves the returned evaluation of p, not the claimed value of each chunk.
+func VerifyChunked(digests []Digest, proofs []OpeningProof, point fr.Element, chunkSize uint64, vk VerifyingKey) (fr.Element, error) {
+	if len(digests) != len(proofs) {
+		return fr.Element{}, ErrInvalidNbDigests
+	}
+	if len(digests) == 0 {
+		return fr.Element{}, ErrZeroNbDigests
+	}
+	for i := range digests {
+		if !digests[i].IsInSubGroup() {
+			return fr.Element{}, ErrCommitmentNotInSubgroup
+		}
+		if !proofs[i].H.IsInSubGroup() {
+			return fr.Element{}, ErrQuotientNotNotInSubgroup
+		}
+	}
+
+	// cᵢ = pointⁿⁱ
+	var pointN fr.Element
+	pointN.Exp(point, new(big.Int).SetUint64(chunkSize))
+	coeffs := make([]fr.Element, len(digests))
+	coeffs[0].SetOne()
+	for i := 1; i < len(coeffs); i++ {
+		coeffs[i].Mul(&coeffs[i-1], &pointN)
+	}
+
+	// ∑ᵢcᵢ[pᵢ(α)]G₁ and ∑ᵢcᵢpᵢ(point)
+	claimedValues := make([]fr.Element, len(proofs))
+	quotients := make([]bls24317.G1Affine, len(proofs))
+	for i := range proofs {
+		claimedValues[i].Set(&proofs[i].ClaimedValue)
+		quotients[i].Set(&proofs[i].H)
+	}
+	foldedDigest, foldedValue, err := fold(digests, claimedValues, coeffs)
+	if err != nil {
+		return fr.Element{}, err
+	}
+
+	// ∑ᵢcᵢ[Hᵢ(α)]G₁
+	foldedProof := OpeningProof{ClaimedValue: foldedValue}
+	if _, err := foldedProof.H.MultiExp(quotients, coeffs, ecc.MultiExpConfig{}); err != nil {
+		return fr.Element{}, err
+	}
+
+	if err := Verify(&foldedDigest, &foldedProof, point, vk); err != nil {
+		return fr.Element{}, err
+	}
+	return foldedValue, nil
+}
+
+// splitInChunks splits p in consecutive chunks of (at most) n coefficients.
+func splitInChunks(p []fr.Element, n int) [][]fr.Element {
+	chunks := make([][]fr.Element, 0, (len(p)+n-1)/n)
+	for len(p) > n {
+		chunks = append(chunks, p[:n])
+		p = p[n:]
+	}
+	return append(chunks, p)
+}
+
+// OpenMultiPoint computes a single opening proof of polynomial p at several distinct points.
+//
+// The proof commits to the quotient H = (p - I)/Z where Z = ∏ᵢ(X-zᵢ) and I interpolates
+// the claimed values. Since the verifying key only holds [α]G₂, the identity p - I = Z·H
+// is not checked with [Z(α)]G₂ but at a Fiat Shamir challenge γ (https://eprint.iacr.org/2020/081.pdf):
+// L = p - Z(γ)H satisfies L(γ) = I(γ), which is proven with a regular opening at γ.
+//
+// The challenge is derived with SHA-256 from the commitment of p, the points, the claimed
+// values and H; the commitment is recomputed here so that the transcript is bound to it.
+func OpenMultiPoint(p []fr.Element, points []fr.Element, pk ProvingKey) (MultiPointProof, error) {
+	if len(p) == 0 || len(p) > len(pk.G1) {
+		return MultiPointProof{}, ErrInvalidPolynomialSize
+	}
+	if len(points) == 0 {
+		return MultiPointProof{}, ErrInvalidNbPoints
+	}
+	if err := checkDistinct(points); err != nil {
+		return MultiPointProof{}, err
+	}
+
+	res := MultiPointProof{
+		ClaimedValues: make([]fr.Element, len(points)),
+	}
+	for i := range points {
+		res.ClaimedValues[i] = eval(p, points[i])
+	}
+
+	// h = p / Z, dividing successively by each (X-zᵢ) and dropping the remainders
+	h := make([]fr.Element, len(p))
+	copy(h, p)
+	for i := range points {
+		h = dividePolyByXminusA(h, eval(h, points[i]), points[i])
+	}
+	hCommit, err := Commit(h, pk)
+	if err != nil {
+		return MultiPointProof{}, err
+	}
+	res.H.Set(&hCommit)
+
+	digest, err := Commit(p, pk)
+	if err != nil {
+		return MultiPointProof{}, err
+	}
+	gamma, err := deriveMultiPointChallenge(&digest, points, &res)
+	if err != nil {
+		return MultiPointProof{}, err
+	}
+
+	// l = p - Z(γ)h
+	zGamma := evalVanishing(points, gamma)
+	l := make([]fr.Element, len(p))
+	copy(l, p)
+	var t fr.Element
+	for i := range h {
+		t.Mul(&h[i], &zGamma)
+		l[i].Sub(&l[i], &t)
+	}
+	l = dividePolyByXminusA(l, eval(l, gamma), gamma)
+	hFolded, err := Commit(l, pk)
+	if err != nil {
+		return MultiPointProof{}, err
+	}
+	res.HFolded.Set(&hFolded)
+
+	return res, nil
+}
+
+// VerifyMultiPoint verifies a KZG opening proof of a committed polynomial at several
+// distinct points, as computed by OpenMultiPoint.
+func VerifyMultiPoint(commitment *Digest, proof *MultiPointProof, points []fr.Element, vk VerifyingKey) error {
+	if len(points) == 0 || len(points) != len(proof.ClaimedValues) {
+		return ErrInvalidNbPoints
+	}
+	if err := checkDistinct(points); err != nil {
+		return err
+	}
+	if !commitment.IsInSubGroup() {
+		return ErrCommitmentNotInSubgroup
+	}
+	if !proof.H.IsInSubGroup() {
+		return ErrQuotientNotNotInSubgroup
+	}
+
+	gamma, err := deriveMultiPointChallenge(commitment, points, proof)
+	if err != nil {
+		return err
+	}
+
+	// [L(α)]G₁ = [f(α)]G₁ - Z(γ)[H(α)]G₁
+	var zGammaInt big.Int
+	zGamma := evalVanishing(points, gamma)
+	zGamma.BigInt(&zGammaInt)
+	var l, hZ bls24317.G1Jac
+	l.FromAffine(commitment)
+	hZ.FromAffine(&proof.H)
+	hZ.ScalarMultiplication(&hZ, &zGammaInt)
+	l.SubAssign(&hZ)
+	var lAff Digest
+	lAff.FromJacobian(&l)
+
+	// L(γ) = I(γ)
+	folded := OpeningProof{
+		H:            proof.HFolded,
+		ClaimedValue: evalInterpolation(points, proof.ClaimedValues, gamma),
+	}
+	return Verify(&lAff, &folded, gamma, vk)
+}
+
+// checkDistinct returns ErrDuplicatePoint if a point appears twice.
+func checkDistinct(points []fr.Element) error {
+	seen := make(map[fr.Element]struct{}, len(points))
+	for i := range points {
+		if _, ok := seen[points[i]]; ok {
+			return ErrDuplicatePoint
+		}
+		seen[points[i]] = struct{}{}
+	}
+	return nil
+}
+
+// evalVanishing returns ∏ᵢ(x-zᵢ).
+func evalVanishing(points []fr.Element, x fr.Element) fr.Element {
+	var res, t fr.Element
+	res.SetOne()
+	for i := range points {
+		t.Sub(&x, &points[i])
+		res.Mul(&res, &t)
+	}
+	return res
+}
+
+// evalInterpolation returns I(x) where I is the polynomial of degree < len(points)
+// such that I(zᵢ) = values[i]. The points must be distinct.
+func evalInterpolation(points, values []fr.Element, x fr.Element) fr.Element {
+	// I(x) = ∑ᵢ values[i] ∏_{j≠i}(x-zⱼ)/(zᵢ-zⱼ)
+	num := make([]fr.Element, len(points))
+	den := make([]fr.Element, len(points))
+	var t fr.Element
+	for i := range points {
+		num[i].Set(&values[i])
+		den[i].SetOne()
+		for j := range points {
+			if j == i {
+				continue
+			}
+			t.Sub(&x, &points[j])
+			num[i].Mul(&num[i], &t)
+			t.Sub(&points[i], &points[j])
+			den[i].Mul(&den[i], &t)
+		}
+	}
+	den = fr.BatchInvert(den)
+
+	var res fr.Element
+	for i := range num {
+		t.Mul(&num[i], &den[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// deriveMultiPointChallenge derives the challenge γ of a multi-point opening, binded
+// to the commitment, the points, the claimed values and the quotient H.
+func deriveMultiPointChallenge(commitment *Digest, points []fr.Element, proof *MultiPointProof) (fr.Element, error) {
+	fs := fiatshamir.NewTranscript(sha256.New(), "gamma")
+	if err := fs.Bind("gamma", commitment.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range points {
+		if err := fs.Bind("gamma", points[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range proof.ClaimedValues {
+		if err := fs.Bind("gamma", proof.ClaimedValues[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := fs.Bind("gamma", proof.H.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+
+	gammaByte, err := fs.ComputeChallenge("gamma")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var gamma fr.Element
+	gamma.SetBytes(gammaByte)
+
+	return gamma, nil
+}
+
 // BatchOpenSinglePoint creates a batch opening proof at point of a list of polynomials.
 // It's an interactive protocol, made non-interactive using Fiat Shamir.
 //
@@ -7,7 +7,10 @@ package bls24317
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"reflect"
 	"sync/atomic"
@@ -464,6 +467,100 @@ func NoSubgroupChecks() func(*Decoder) {
 	}
 }
 
+// SubGroupCheckMode selects how [DecodeG1Slice] checks that the decoded points
+// are in the prime order subgroup.
+type SubGroupCheckMode uint8
+
+const (
+	// FullCheck checks each point individually. When a check fails, the
+	// returned error identifies the index of the point.
+	FullCheck SubGroupCheckMode = iota
+	// BatchCheck checks that the points are on the curve individually, and then
+	// that they are all in the subgroup at once, see [IsInSubGroupBatchG1].
+	// When the check fails, the returned error doesn't identify the point.
+	BatchCheck
+	// NoCheck disables subgroup checks. Use with caution, as crafted points from
+	// an untrusted source can lead to crypto-attacks.
+	NoCheck
+)
+
+// DecodeG1Slice reads n G1 points from r, each in compressed or uncompressed
+// form as produced by [G1Affine.Bytes] or [G1Affine.RawBytes]. Unlike
+// Decoder.Decode on a *[]G1Affine, the number of points is given by the
+// caller and not read from r.
+//
+// The subgroup checks are done once all the points are decoded, according to
+// check.
+func DecodeG1Slice(r io.Reader, n int, check SubGroupCheckMode) ([]G1Affine, error) {
+	if n < 0 {
+		return nil, errors.New("invalid number of points")
+	}
+	points := make([]G1Affine, n)
+	compressed := make([]bool, n)
+
+	var buf [SizeOfG1AffineUncompressed]byte
+	for i := range points {
+		// we start by reading compressed point size, if metadata tells us it is uncompressed, we read more.
+		if _, err := io.ReadFull(r, buf[:SizeOfG1AffineCompressed]); err != nil {
+			return nil, err
+		}
+
+		// 111, 011, 001  --> invalid mask
+		if isMaskInvalid(buf[0]) {
+			return nil, fmt.Errorf("point %d: %w", i, ErrInvalidEncoding)
+		}
+
+		// most significant byte contains metadata
+		if !isCompressed(buf[0]) {
+			if _, err := io.ReadFull(r, buf[SizeOfG1AffineCompressed:SizeOfG1AffineUncompressed]); err != nil {
+				return nil, err
+			}
+			if _, err := points[i].setBytes(buf[:SizeOfG1AffineUncompressed], false); err != nil {
+				return nil, fmt.Errorf("point %d: %w", i, err)
+			}
+			continue
+		}
+		isInfinity, err := points[i].unsafeSetCompressedBytes(buf[:SizeOfG1AffineCompressed])
+		if err != nil {
+			return nil, fmt.Errorf("point %d: %w", i, err)
+		}
+		compressed[i] = !isInfinity
+	}
+
+	// compute the Y coordinates of the compressed points and, for FullCheck,
+	// do the subgroup checks.
+	errs := make([]error, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			if compressed[i] {
+				errs[i] = points[i].unsafeComputeY(check == FullCheck)
+				continue
+			}
+			switch check {
+			case FullCheck:
+				if !points[i].IsInSubGroup() {
+					errs[i] = errors.New("invalid point: subgroup check failed")
+				}
+			case BatchCheck:
+				if !points[i].IsOnCurve() {
+					errs[i] = errors.New("invalid point: not on curve")
+				}
+			}
+		}
+	})
+	for i := range errs {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("point %d: %w", i, errs[i])
+		}
+	}
+
+	if check == BatchCheck && !IsInSubGroupBatchG1(points) {
+		return nil, errors.New("invalid points: batch subgroup check failed")
+	}
+
+	return points, nil
+}
+
 // isZeroed checks that the provided bytes are at 0
 func isZeroed(firstByte byte, buf []byte) bool {
 	if firstByte != 0 {
@@ -822,6 +919,42 @@ func (p *G1Affine) Unmarshal(buf []byte) error {
 	return err
 }
 
+// MarshalJSON returns the JSON encoding of p as a 0x-prefixed hex string of its
+// compressed binary form (see Bytes).
+// If p == nil, returns null
+func (p *G1Affine) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+	b := p.Bytes()
+	return json.Marshal("0x" + hex.EncodeToString(b[:]))
+}
+
+// UnmarshalJSON accepts a 0x-prefixed hex string holding the compressed or
+// uncompressed binary form of a point, as accepted by SetBytes.
+// In particular, the point is checked to be on the curve and in the subgroup.
+func (p *G1Affine) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return errors.New("invalid G1Affine encoding: missing 0x prefix")
+	}
+	buf, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return err
+	}
+	n, err := p.SetBytes(buf)
+	if err != nil {
+		return err
+	}
+	if n != len(buf) {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
 // Bytes returns binary representation of p
 // will store X coordinate in regular form and a parity bit
 // we follow the BLS12-381 style encoding as specified in ZCash and now IETF
@@ -892,6 +1025,18 @@ func (p *G1Affine) SetBytes(buf []byte) (int, error) {
 	return p.setBytes(buf, true)
 }
 
+// SetBytesUnchecked is like SetBytes but doesn't check that the resulting point
+// is in the correct subgroup. For uncompressed input (RawBytes() output), it
+// doesn't check that the point is on the curve either, and so doesn't do any
+// curve arithmetic. The coordinates must still be canonical.
+//
+// This is unsafe: it must only be used to load trusted data, for instance
+// points previously serialized by the caller. Using an invalid point breaks
+// the security of most protocols.
+func (p *G1Affine) SetBytesUnchecked(buf []byte) (int, error) {
+	return p.setBytes(buf, false)
+}
+
 func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	if len(buf) < SizeOfG1AffineCompressed {
 		return 0, io.ErrShortBuffer
@@ -1083,6 +1228,42 @@ func (p *G2Affine) Unmarshal(buf []byte) error {
 	return err
 }
 
+// MarshalJSON returns the JSON encoding of p as a 0x-prefixed hex string of its
+// compressed binary form (see Bytes).
+// If p == nil, returns null
+func (p *G2Affine) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+	b := p.Bytes()
+	return json.Marshal("0x" + hex.EncodeToString(b[:]))
+}
+
+// UnmarshalJSON accepts a 0x-prefixed hex string holding the compressed or
+// uncompressed binary form of a point, as accepted by SetBytes.
+// In particular, the point is checked to be on the curve and in the subgroup.
+func (p *G2Affine) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return errors.New("invalid G2Affine encoding: missing 0x prefix")
+	}
+	buf, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return err
+	}
+	n, err := p.SetBytes(buf)
+	if err != nil {
+		return err
+	}
+	if n != len(buf) {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
 // Bytes returns binary representation of p
 // will store X coordinate in regular form and a parity bit
 // we follow the BLS12-381 style encoding as specified in ZCash and now IETF
@@ -1165,6 +1346,18 @@ func (p *G2Affine) SetBytes(buf []byte) (int, error) {
 	return p.setBytes(buf, true)
 }
 
+// SetBytesUnchecked is like SetBytes but doesn't check that the resulting point
+// is in the correct subgroup. For uncompressed input (RawBytes() output), it
+// doesn't check that the point is on the curve either, and so doesn't do any
+// curve arithmetic. The coordinates must still be canonical.
+//
+// This is unsafe: it must only be used to load trusted data, for instance
+// points previously serialized by the caller. Using an invalid point breaks
+// the security of most protocols.
+func (p *G2Affine) SetBytesUnchecked(buf []byte) (int, error) {
+	return p.setBytes(buf, false)
+}
+
 func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	if len(buf) < SizeOfG2AffineCompressed {
 		return 0, io.ErrShortBuffer
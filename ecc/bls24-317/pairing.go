@@ -34,6 +34,10 @@ func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
 // PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
 // ∏ᵢ e(Pᵢ, Qᵢ) =? 1
 //
+// An equality e(A, B) =? e(C, D) is checked as e(A, B)⋅e(C, -D) =? 1, that is
+// PairingCheck([]G1Affine{A, C}, []G2Affine{B, PrecomputeNegated(D)}). When D
+// is fixed, -D should be computed once and reused across checks.
+//
 // This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
 func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
 	f, err := Pair(P, Q)
@@ -45,6 +49,14 @@ func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
 	return f.Equal(&one), nil
 }
 
+// PrecomputeNegated returns -Q, to be passed to PairingCheck (or to PrecomputeLines
+// when Q is fixed) in place of Q when checking e(A, B) =? e(C, Q).
+func PrecomputeNegated(Q G2Affine) G2Affine {
+	var negQ G2Affine
+	negQ.Neg(&Q)
+	return negQ
+}
+
 // FinalExponentiation computes the exponentiation (∏ᵢ zᵢ)ᵈ
 // where d = (p²⁴-1)/r = (p²⁴-1)/Φ₂₄(p) ⋅ Φ₂₄(p)/r = (p¹²-1)(p⁴+1)(p⁸ - p⁴ +1)/r
 // we use instead d=s ⋅ (p¹²-1)(p⁴+1)(p⁸ - p⁴ +1)/r
@@ -111,8 +123,29 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 	return result
 }
 
+// MillerLoopChecked is MillerLoop, but it first checks that the points of P
+// are in G1 and the points of Q in G2, and returns an error otherwise.
+func MillerLoopChecked(P []G1Affine, Q []G2Affine) (GT, error) {
+	if len(P) != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+	for i := range P {
+		if !P[i].IsInSubGroup() {
+			return GT{}, errors.New("a point of P is not in G1")
+		}
+		if !Q[i].IsInSubGroup() {
+			return GT{}, errors.New("a point of Q is not in G2")
+		}
+	}
+	return MillerLoop(P, Q)
+}
+
 // MillerLoop computes the multi-Miller loop
 // ∏ᵢ MillerLoop(Pᵢ, Qᵢ) = ∏ᵢ { fᵢ_{x,Qᵢ}(Pᵢ) }
+//
+// The result is not reduced: several Miller loops can be multiplied together,
+// or passed to FinalExponentiation at once, before a single final exponentiation.
+// This function doesn't check that the inputs are in the correct subgroup. See MillerLoopChecked.
 func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	// check input size match
 	n := len(P)
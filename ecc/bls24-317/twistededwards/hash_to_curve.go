@@ -0,0 +1,143 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// HashToEdwards hashes msg to a point of the prime order subgroup, following
+// the hash_to_curve random oracle construction of RFC 9380 (section 3) for
+// twisted Edwards curves:
+//
+//   - u₀, u₁ = hash_to_field(msg, 2) with expand_message_xmd and SHA-256 (fr.Hash)
+//   - Qᵢ = the Elligator 2 map of uᵢ to the Montgomery curve (section 6.7.1),
+//     sent to the twisted Edwards curve with the rational map of appendix D.1
+//   - P = h⋅(Q₀ + Q₁), h being the cofactor
+//
+// dst is the domain separation tag, see section 3.1 of the RFC for its choice.
+//
+// https://www.rfc-editor.org/rfc/rfc9380.html
+func HashToEdwards(msg, dst []byte) (PointAffine, error) {
+	u, err := fr.Hash(msg, dst, 2)
+	if err != nil {
+		return PointAffine{}, err
+	}
+
+	var q0, q1 PointAffine
+	q0 = mapToEdwards(&u[0])
+	q1 = mapToEdwards(&u[1])
+	q0.Add(&q0, &q1)
+
+	initOnce.Do(initCurveParams)
+	var cofactor big.Int
+	curveParams.Cofactor.BigInt(&cofactor)
+	q0.ScalarMultiplication(&q0, &cofactor)
+
+	return q0, nil
+}
+
+var (
+	elligatorOnce sync.Once
+	// elligatorZ is the non-square of RFC 9380 find_z_ell2 (appendix H.3)
+	elligatorZ fr.Element
+	// montgomeryA and montgomeryB are the coefficients of the Montgomery curve,
+	// see MontgomeryCoefficients
+	montgomeryA, montgomeryB fr.Element
+)
+
+func initElligator() {
+	montgomeryA, montgomeryB = MontgomeryCoefficients()
+
+	// Z is the first of 1, -1, 2, -2, ... which is not a square
+	for ctr := uint64(1); ; ctr++ {
+		elligatorZ.SetUint64(ctr)
+		if elligatorZ.Legendre() == -1 {
+			return
+		}
+		elligatorZ.Neg(&elligatorZ)
+		if elligatorZ.Legendre() == -1 {
+			return
+		}
+	}
+}
+
+// mapToEdwards maps u to the twisted Edwards curve with the Elligator 2 map to
+// the Montgomery curve followed by the rational map to the twisted Edwards
+// curve. The result is not in the prime order subgroup in general.
+func mapToEdwards(u *fr.Element) PointAffine {
+	s, t := mapToMontgomeryElligator2(u)
+	return montgomeryToEdwardsRational(&s, &t)
+}
+
+// mapToMontgomeryElligator2 implements the Elligator 2 map of RFC 9380 (section
+// 6.7.1) to the Montgomery curve B⋅t² = s³ + A⋅s² + s.
+func mapToMontgomeryElligator2(u *fr.Element) (s, t fr.Element) {
+	elligatorOnce.Do(initElligator)
+
+	var one, aOverB, bInv, x1, gx1, x2, gx2, tmp fr.Element
+	one.SetOne()
+	bInv.Inverse(&montgomeryB)
+	aOverB.Mul(&montgomeryA, &bInv)
+
+	// x1 = -(A/B) / (1 + Z⋅u²), inv0(0) being 0
+	tmp.Square(u).Mul(&tmp, &elligatorZ).Add(&tmp, &one)
+	tmp.Inverse(&tmp)
+	x1.Mul(&aOverB, &tmp).Neg(&x1)
+	if x1.IsZero() {
+		x1.Neg(&aOverB)
+	}
+
+	// gx = x³ + (A/B)⋅x² + x/B² = x⋅(x⋅(x + A/B) + 1/B²)
+	var bInvSquare fr.Element
+	bInvSquare.Square(&bInv)
+	gx1.Add(&x1, &aOverB).Mul(&gx1, &x1).Add(&gx1, &bInvSquare).Mul(&gx1, &x1)
+
+	var x, y fr.Element
+	if y.Sqrt(&gx1) != nil {
+		// sgn0(y) = 1
+		x.Set(&x1)
+		if y.Bits()[0]&1 == 0 {
+			y.Neg(&y)
+		}
+	} else {
+		// then gx2 = gx1⋅Z⋅u² is a square
+		x2.Add(&x1, &aOverB).Neg(&x2)
+		gx2.Add(&x2, &aOverB).Mul(&gx2, &x2).Add(&gx2, &bInvSquare).Mul(&gx2, &x2)
+		y.Sqrt(&gx2)
+		// sgn0(y) = 0
+		x.Set(&x2)
+		if y.Bits()[0]&1 == 1 {
+			y.Neg(&y)
+		}
+	}
+
+	s.Mul(&x, &montgomeryB)
+	t.Mul(&y, &montgomeryB)
+	return
+}
+
+// montgomeryToEdwardsRational implements the rational map of RFC 9380
+// (appendix D.1) (s, t) ↦ (s/t, (s-1)/(s+1)), the exceptional points t = 0 or
+// s = -1 being sent to the identity.
+func montgomeryToEdwardsRational(s, t *fr.Element) PointAffine {
+	var res PointAffine
+	var one, sPlusOne, inv fr.Element
+	one.SetOne()
+	sPlusOne.Add(s, &one)
+	inv.Mul(&sPlusOne, t)
+	if inv.IsZero() {
+		res.Y.SetOne()
+		return res
+	}
+	inv.Inverse(&inv)
+	res.X.Mul(&inv, &sPlusOne).Mul(&res.X, s)
+	res.Y.Sub(s, &one).Mul(&res.Y, &inv).Mul(&res.Y, t)
+	return res
+}
@@ -6,12 +6,15 @@
 package twistededwards
 
 import (
+	"bytes"
 	"crypto/rand"
+	"io"
 	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
 	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 )
 
@@ -824,6 +827,192 @@ func TestOps(t *testing.T) {
 
 }
 
+func TestGenerateBasePoint(t *testing.T) {
+	t.Parallel()
+
+	p := GenerateBasePoint()
+	if !p.IsOnCurve() || p.IsZero() {
+		t.Fatal("generated base point should be a non-zero point on the curve")
+	}
+
+	// the generated point is in the prime order subgroup
+	params := GetEdwardsCurve()
+	var q PointAffine
+	q.ScalarMultiplication(&p, &params.Order)
+	if !q.IsZero() {
+		t.Fatal("generated base point should be in the prime order subgroup")
+	}
+
+	// the derivation is deterministic
+	q = GenerateBasePoint()
+	if !q.Equal(&p) {
+		t.Fatal("GenerateBasePoint should be deterministic")
+	}
+
+	// the embedded base point was not derived with GenerateBasePoint, it is
+	// only checked to be in the same subgroup
+	if p.Equal(&params.Base) {
+		t.Fatal("embedded base point should differ from the generated one, update the doc of GenerateBasePoint")
+	}
+	q.ScalarMultiplication(&params.Base, &params.Order)
+	if !q.IsZero() {
+		t.Fatal("embedded base point should be in the prime order subgroup")
+	}
+}
+
+func TestScalarMultiplicationReference(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	params := GetEdwardsCurve()
+
+	properties.Property("ScalarMultiplication should match the double-and-add reference on random points", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+			var base, expected, p PointAffine
+			scalarMulReference(&base, &params.Base, &s1)
+
+			scalarMulReference(&expected, &base, &s2)
+			p.ScalarMultiplication(&base, &s2)
+			if !p.Equal(&expected) {
+				return false
+			}
+
+			// extended coordinates
+			var baseExt, pExt PointExtended
+			baseExt.FromAffine(&base)
+			pExt.ScalarMultiplication(&baseExt, &s2)
+			p.FromExtended(&pExt)
+			if !p.Equal(&expected) {
+				return false
+			}
+
+			s2.Neg(&s2)
+			scalarMulReference(&expected, &base, &s2)
+			p.ScalarMultiplication(&base, &s2)
+			return p.Equal(&expected)
+		},
+		GenBigInt(),
+		GenBigInt(),
+	))
+
+	properties.Property("ScalarMultiplication should match the double-and-add reference on small scalars", prop.ForAll(
+		func(s1 big.Int, s2 uint8) bool {
+			var base, expected, p PointAffine
+			scalarMulReference(&base, &params.Base, &s1)
+
+			s := big.NewInt(int64(s2))
+			scalarMulReference(&expected, &base, s)
+			p.ScalarMultiplication(&base, s)
+			return p.Equal(&expected)
+		},
+		GenBigInt(),
+		gen.UInt8(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// scalarMulReference is the double-and-add scalar multiplication in extended
+// coordinates with mixed additions, used as a reference in tests.
+func scalarMulReference(p, p1 *PointAffine, scalar *big.Int) *PointAffine {
+	var _scalar big.Int
+	_scalar.Set(scalar)
+	p.Set(p1)
+	if _scalar.Sign() == -1 {
+		_scalar.Neg(&_scalar)
+		p.Neg(p)
+	}
+	var resExtended PointExtended
+	resExtended.setInfinity()
+	for i := _scalar.BitLen() - 1; i >= 0; i-- {
+		resExtended.Double(&resExtended)
+		if _scalar.Bit(i) == 1 {
+			resExtended.MixedAdd(&resExtended, p)
+		}
+	}
+	p.FromExtended(&resExtended)
+	return p
+}
+
+func TestScalarMulLadder(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	params := GetEdwardsCurve()
+
+	properties.Property("ScalarMulLadder should match ScalarMultiplication", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+			// random point
+			var base, expected, p PointAffine
+			base.ScalarMultiplication(&params.Base, &s1)
+
+			expected.ScalarMultiplication(&base, &s2)
+			p.ScalarMulLadder(&base, &s2)
+			if !p.Equal(&expected) {
+				return false
+			}
+
+			// negative scalar
+			s2.Neg(&s2)
+			expected.ScalarMultiplication(&base, &s2)
+			p.ScalarMulLadder(&base, &s2)
+			if !p.Equal(&expected) {
+				return false
+			}
+
+			// scalar larger than the scalar field
+			s2.Neg(&s2).Lsh(&s2, 64)
+			expected.ScalarMultiplication(&base, &s2)
+			p.ScalarMulLadder(&base, &s2)
+			return p.Equal(&expected)
+		},
+		GenBigInt(),
+		GenBigInt(),
+	))
+
+	properties.Property("ScalarMulLadder: having the receiver as operand should output the same result", prop.ForAll(
+		func(s big.Int) bool {
+			var p1, p2 PointAffine
+			p1.Set(&params.Base)
+			p2.ScalarMulLadder(&p1, &s)
+			p1.ScalarMulLadder(&p1, &s)
+			return p1.Equal(&p2)
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var infinity, p PointAffine
+	infinity.setInfinity()
+	if !p.ScalarMulLadder(&params.Base, big.NewInt(0)).Equal(&infinity) {
+		t.Fatal("[0]P should be the neutral element")
+	}
+	if !p.ScalarMulLadder(&infinity, big.NewInt(12345)).Equal(&infinity) {
+		t.Fatal("[s]O should be the neutral element")
+	}
+	if !p.ScalarMulLadder(&params.Base, &params.Order).Equal(&infinity) {
+		t.Fatal("[order]base should be the neutral element")
+	}
+	if !p.ScalarMulLadder(&params.Base, big.NewInt(1)).Equal(&params.Base) {
+		t.Fatal("[1]base should be base")
+	}
+}
+
 func TestMarshal(t *testing.T) {
 	t.Parallel()
 	initOnce.Do(initCurveParams)
@@ -840,6 +1029,85 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestBytesCompressed(t *testing.T) {
+	t.Parallel()
+	initOnce.Do(initCurveParams)
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+	genS := GenBigInt()
+
+	properties.Property("SetBytesCompressed(BytesCompressed(P)) == P", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var p1, p2, p3 PointAffine
+			p1.ScalarMultiplication(&params.Base, &s)
+			p3.Neg(&p1)
+
+			b := p1.BytesCompressed()
+			n, err := p2.SetBytesCompressed(b)
+			if err != nil || n != sizePointCompressed || !p2.Equal(&p1) {
+				return false
+			}
+
+			// -P only differs in the sign bit
+			b3 := p3.BytesCompressed()
+			b3[sizePointCompressed-1] ^= 0x80
+			return p1.X.IsZero() || bytes.Equal(b, b3)
+		},
+		genS,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// neutral element
+	var zero, p PointAffine
+	zero.setInfinity()
+	if _, err := p.SetBytesCompressed(zero.BytesCompressed()); err != nil || !p.Equal(&zero) {
+		t.Fatal("error SetBytesCompressed(BytesCompressed(0))")
+	}
+
+	// x = 0 with the sign bit set
+	b := zero.BytesCompressed()
+	b[sizePointCompressed-1] |= 0x80
+	if _, err := p.SetBytesCompressed(b); err != errNotOnCurve {
+		t.Fatal("should reject x = 0 with the sign bit set")
+	}
+
+	// short buffer
+	if _, err := p.SetBytesCompressed(b[:sizePointCompressed-1]); err != io.ErrShortBuffer {
+		t.Fatal("should reject a short buffer")
+	}
+
+	// y such that (1-y²)/(a-d*y²) is not a square
+	var y, one, num, den fr.Element
+	one.SetOne()
+	for {
+		y.MustSetRandom()
+		num.Square(&y)
+		den.Mul(&num, &curveParams.D)
+		num.Sub(&one, &num)
+		den.Sub(&curveParams.A, &den)
+		num.Div(&num, &den)
+		if num.Legendre() == -1 {
+			break
+		}
+	}
+	p.X.SetZero()
+	p.Y.Set(&y)
+	if _, err := p.SetBytesCompressed(p.BytesCompressed()); err != errNotOnCurve {
+		t.Fatal("should reject a y-coordinate with no matching x-coordinate")
+	}
+}
+
 // GenBigInt generates a big.Int
 // TODO @thomas we use fr size as max bound here
 func GenBigInt() gopter.Gen {
@@ -935,6 +1203,36 @@ func BenchmarkScalarMulProjective(b *testing.B) {
 	}
 }
 
+func BenchmarkScalarMulAffine(b *testing.B) {
+	params := GetEdwardsCurve()
+	var a PointAffine
+	var s big.Int
+	s.SetString("52435875175126190479447740508185965837690552500527637822603658699938581184511", 10)
+
+	b.Run("double-and-add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			scalarMulReference(&a, &params.Base, &s)
+		}
+	})
+	b.Run("ScalarMultiplication", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.ScalarMultiplication(&params.Base, &s)
+		}
+	})
+}
+
+func BenchmarkScalarMulLadder(b *testing.B) {
+	params := GetEdwardsCurve()
+	var a PointAffine
+	var s big.Int
+	s.SetString("52435875175126190479447740508185965837690552500527637822603658699938581184511", 10)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.ScalarMulLadder(&params.Base, &s)
+	}
+}
+
 func BenchmarkNeg(b *testing.B) {
 	params := GetEdwardsCurve()
 	var s big.Int
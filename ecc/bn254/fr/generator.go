@@ -13,15 +13,21 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 )
 
+// maxOrderRoot is the 2-adicity of the field, i.e. the largest k such that
+// 2^k divides q-1, and rootOfUnityMaxOrder is a generator of the subgroup of
+// order 2^maxOrderRoot.
+const (
+	maxOrderRoot        uint64 = 28
+	rootOfUnityMaxOrder        = "19103219067921713944291392827692070036145651957329286315305642004821462161904"
+)
+
 // Generator returns a generator for Z/2^(log(m))Z
 // or an error if m is too big (required root of unity doesn't exist)
 func Generator(m uint64) (Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity Element
-
-	rootOfUnity.SetString("19103219067921713944291392827692070036145651957329286315305642004821462161904")
-	const maxOrderRoot uint64 = 28
+	rootOfUnity.SetString(rootOfUnityMaxOrder)
 
 	// find generator for Z/2^(log(m))Z
 	logx := uint64(bits.TrailingZeros64(x))
@@ -34,3 +40,18 @@ func Generator(m uint64) (Element, error) {
 	generator.Exp(rootOfUnity, big.NewInt(int64(expo))) // order x
 	return generator, nil
 }
+
+// TwoAdicity returns the 2-adic valuation of q-1, i.e. the largest k such
+// that the multiplicative group has a subgroup of order 2^k.
+func TwoAdicity() uint64 {
+	return maxOrderRoot
+}
+
+// RootOfUnity returns a generator of the subgroup of order order, which must be
+// a power of two not larger than 2^TwoAdicity().
+func RootOfUnity(order uint64) (Element, error) {
+	if order == 0 || order&(order-1) != 0 {
+		return Element{}, fmt.Errorf("order (%d) is not a power of two", order)
+	}
+	return Generator(order)
+}
@@ -0,0 +1,311 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+var (
+	// ErrInterpolationSize is returned when interpolating from different numbers of x and y values.
+	ErrInterpolationSize = errors.New("number of x and y values differ")
+	// ErrDuplicateInterpolationPoint is returned when interpolating from x values which are not distinct.
+	ErrDuplicateInterpolationPoint = errors.New("interpolation points must be distinct")
+)
+
+const (
+	// multipointThreshold is the number of points (and of coefficients) below
+	// which EvaluateMany evaluates the polynomial at each point with Horner's method.
+	multipointThreshold = 4096
+
+	// subproductLeafSize is the number of points per leaf of the subproduct tree.
+	subproductLeafSize = 16
+
+	// karatsubaThreshold is the operand length below which polynomials are
+	// multiplied with the schoolbook method.
+	karatsubaThreshold = 32
+)
+
+// EvaluateMany evaluates p at each of the points and returns the evaluations,
+// in the same order as the points.
+//
+// For large inputs it uses fast multipoint evaluation: p is reduced modulo the
+// products ∏(X - xᵢ) of a subproduct tree built over the points, down to
+// remainders of small degree which are evaluated with Horner's method. This
+// costs O(M(n) log n) field operations, M(n) being the cost of multiplying two
+// polynomials of degree n (Karatsuba), instead of O(n²) for n Horner passes.
+func (p *Polynomial) EvaluateMany(points []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(points))
+	if len(*p) == 0 {
+		return res
+	}
+	if len(points) < multipointThreshold || len(*p) < multipointThreshold {
+		for i := range points {
+			res[i] = p.Eval(&points[i])
+		}
+		return res
+	}
+
+	tree := newSubproductTree(points)
+	tree.evaluate(*p, res)
+	return res
+}
+
+// Interpolate returns the polynomial f of degree < len(x) such that f(x[i]) = y[i].
+// The x values must be distinct, but unlike the FFT based interpolation they
+// don't need to form a multiplicative subgroup.
+//
+// It uses Lagrange interpolation f = ∑ᵢ yᵢ/m'(xᵢ) ⋅ m/(X - xᵢ), m = ∏(X - xᵢ),
+// the m'(xᵢ) being computed with a multipoint evaluation and the sum being
+// recombined along the subproduct tree built over the x values.
+func Interpolate(x, y []fr.Element) (Polynomial, error) {
+	if len(x) != len(y) {
+		return nil, ErrInterpolationSize
+	}
+	if len(x) == 0 {
+		return Polynomial{}, nil
+	}
+
+	tree := newSubproductTree(x)
+
+	// m'(xᵢ) = ∏_{j≠i}(xᵢ - xⱼ), which is zero iff xᵢ is repeated
+	dm := make(Polynomial, len(tree.m)-1)
+	for i := range dm {
+		dm[i].SetUint64(uint64(i + 1))
+		dm[i].Mul(&dm[i], &tree.m[i+1])
+	}
+	weights := make([]fr.Element, len(x))
+	if len(x) < multipointThreshold {
+		for i := range x {
+			weights[i] = dm.Eval(&x[i])
+		}
+	} else {
+		tree.evaluate(dm, weights)
+	}
+	for i := range weights {
+		if weights[i].IsZero() {
+			return nil, ErrDuplicateInterpolationPoint
+		}
+	}
+	weights = fr.BatchInvert(weights)
+	for i := range weights {
+		weights[i].Mul(&weights[i], &y[i])
+	}
+
+	return tree.linearCombination(weights), nil
+}
+
+// subproductTree is a node of the tree of the products ∏(X - xᵢ) over
+// consecutive ranges of points.
+type subproductTree struct {
+	points      []fr.Element
+	m           Polynomial // ∏(X - xᵢ) over points, monic
+	left, right *subproductTree
+}
+
+func newSubproductTree(points []fr.Element) *subproductTree {
+	t := &subproductTree{points: points}
+	if len(points) <= subproductLeafSize {
+		t.m = make(Polynomial, len(points)+1)
+		t.m[0].SetOne()
+		for i := range points {
+			// t.m ← t.m · (X - xᵢ), t.m having i+1 coefficients
+			var tmp fr.Element
+			for j := i + 1; j > 0; j-- {
+				tmp.Mul(&t.m[j], &points[i])
+				t.m[j].Sub(&t.m[j-1], &tmp)
+			}
+			t.m[0].Mul(&t.m[0], &points[i]).Neg(&t.m[0])
+		}
+		return t
+	}
+
+	mid := len(points) / 2
+	t.left = newSubproductTree(points[:mid])
+	t.right = newSubproductTree(points[mid:])
+	t.m = karatsubaMul(t.left.m, t.right.m)
+	return t
+}
+
+// evaluate sets res[i] = f(t.points[i])
+func (t *subproductTree) evaluate(f Polynomial, res []fr.Element) {
+	if len(f) >= len(t.m) {
+		f = remMonic(f, t.m)
+	}
+	if t.left == nil {
+		for i := range t.points {
+			res[i] = f.Eval(&t.points[i])
+		}
+		return
+	}
+	mid := len(t.left.points)
+	t.left.evaluate(f, res[:mid])
+	t.right.evaluate(f, res[mid:])
+}
+
+// remMonic returns f mod g, g being monic of degree d ≥ 1 and deg(f) ≥ d.
+// The remainder has d coefficients.
+//
+// The quotient is computed from the reversed polynomials, as
+// rev(q) = rev(f) · rev(g)⁻¹ mod Xᵗ, the inverse being computed once with
+// Newton's iteration. When deg(f) ≥ 2d, f is reduced by blocks of t = d
+// coefficients, from the top.
+func remMonic(f, g Polynomial) Polynomial {
+	d := len(g) - 1
+	k := len(f) - d
+	if k < karatsubaThreshold || d < karatsubaThreshold {
+		_, r, _ := f.DivRem(g)
+		return r
+	}
+
+	t := min(k, d)
+	rg := make(Polynomial, t)
+	for i := range rg {
+		rg[i] = g[d-i]
+	}
+	inv := inverseSeries(rg, t)
+
+	// replace the top d+t coefficients of r by their remainder modulo g, until
+	// r has d coefficients
+	r := f.Clone()
+	for len(r) > d {
+		t := min(len(r)-d, d)
+		top := r[len(r)-d-t:]
+
+		rTop := make(Polynomial, t)
+		for i := range rTop {
+			rTop[i] = top[len(top)-1-i]
+		}
+		qr := mulTruncated(rTop, inv[:t], t)
+		q := make(Polynomial, t)
+		for i := range q {
+			q[i] = qr[t-1-i]
+		}
+
+		qg := mulTruncated(q, g, d)
+		for i := range qg {
+			top[i].Sub(&top[i], &qg[i])
+		}
+		r = r[:len(r)-t]
+	}
+	return r
+}
+
+// inverseSeries returns b such that a·b = 1 mod Xᵏ. a[0] must be invertible.
+func inverseSeries(a Polynomial, k int) Polynomial {
+	b := make(Polynomial, 1, k)
+	b[0].Inverse(&a[0])
+
+	for l := 1; l < k; {
+		// b ← b·(2 - a·b) mod X²ˡ
+		// a·b = 1 + δXˡ mod X²ˡ so that only the upper half of b changes,
+		// by -b·δ mod Xˡ
+		next := min(2*l, k)
+		delta := mulTruncated(a[:min(next, len(a))], b, next)[l:]
+		delta = mulTruncated(b, delta, next-l)
+		b = b[:next]
+		for i := range delta {
+			b[l+i].Neg(&delta[i])
+		}
+		l = next
+	}
+	return b
+}
+
+// mulTruncated returns the n first coefficients of a·b, padded with zeros.
+func mulTruncated(a, b Polynomial, n int) Polynomial {
+	res := karatsubaMul(a[:min(n, len(a))], b[:min(n, len(b))])
+	if len(res) >= n {
+		return res[:n]
+	}
+	return append(res, make(Polynomial, n-len(res))...)
+}
+
+// karatsubaMul returns a·b
+func karatsubaMul(a, b Polynomial) Polynomial {
+	if len(a) == 0 || len(b) == 0 {
+		return Polynomial{}
+	}
+	res := make(Polynomial, len(a)+len(b)-1)
+	karatsubaMulAcc(res, a, b)
+	return res
+}
+
+// karatsubaMulAcc adds a·b to res[:len(a)+len(b)-1], using Karatsuba's method for large operands.
+func karatsubaMulAcc(res, a, b Polynomial) {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if len(b) < karatsubaThreshold {
+		var t fr.Element
+		for i := range a {
+			for j := range b {
+				t.Mul(&a[i], &b[j])
+				res[i+j].Add(&res[i+j], &t)
+			}
+		}
+		return
+	}
+
+	h := len(a) / 2
+	if len(b) <= h {
+		// unbalanced operands, only a is split
+		karatsubaMulAcc(res, a[:h], b)
+		karatsubaMulAcc(res[h:], a[h:], b)
+		return
+	}
+
+	// a·b = z₀ + (z₁ - z₀ - z₂)Xʰ + z₂X²ʰ
+	// with z₀ = a₀·b₀, z₂ = a₁·b₁ and z₁ = (a₀+a₁)·(b₀+b₁)
+	a0, a1 := a[:h], a[h:]
+	b0, b1 := b[:h], b[h:]
+	z0 := karatsubaMul(a0, b0)
+	z2 := karatsubaMul(a1, b1)
+	var sa, sb Polynomial
+	sa.Add(a0, a1)
+	sb.Add(b0, b1)
+	z1 := karatsubaMul(sa, sb)
+	for i := range z0 {
+		z1[i].Sub(&z1[i], &z0[i])
+		res[i].Add(&res[i], &z0[i])
+	}
+	for i := range z2 {
+		z1[i].Sub(&z1[i], &z2[i])
+		res[2*h+i].Add(&res[2*h+i], &z2[i])
+	}
+	for i := range z1 {
+		res[h+i].Add(&res[h+i], &z1[i])
+	}
+}
+
+// linearCombination returns ∑ᵢ cᵢ ⋅ t.m/(X - xᵢ), which has len(t.points) coefficients.
+func (t *subproductTree) linearCombination(c []fr.Element) Polynomial {
+	if t.left == nil {
+		res := make(Polynomial, len(t.points))
+		q := make(Polynomial, len(t.points))
+		for i := range t.points {
+			// q = t.m/(X - xᵢ), by synthetic division
+			q[len(q)-1] = t.m[len(t.m)-1]
+			for j := len(q) - 1; j > 0; j-- {
+				q[j-1].Mul(&q[j], &t.points[i]).Add(&q[j-1], &t.m[j])
+			}
+			var tmp fr.Element
+			for j := range q {
+				tmp.Mul(&q[j], &c[i])
+				res[j].Add(&res[j], &tmp)
+			}
+		}
+		return res
+	}
+
+	mid := len(t.left.points)
+	// ∑ᵢ cᵢ ⋅ t.m/(X - xᵢ) = right.m ⋅ ∑_{i<mid} cᵢ ⋅ left.m/(X - xᵢ) + left.m ⋅ ∑_{i≥mid} cᵢ ⋅ right.m/(X - xᵢ)
+	res := karatsubaMul(t.left.linearCombination(c[:mid]), t.right.m)
+	karatsubaMulAcc(res, t.right.linearCombination(c[mid:]), t.left.m)
+	return res
+}
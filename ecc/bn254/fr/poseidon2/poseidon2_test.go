@@ -15,8 +15,6 @@ import (
 )
 
 func TestExternalMatrix(t *testing.T) {
-	t.Skip("skipping test - it is initialized for width=4 for which we don't have the diagonal matrix")
-
 	var expected [4][4]fr.Element
 	expected[0][0].SetUint64(5)
 	expected[0][1].SetUint64(4)
@@ -58,6 +56,42 @@ func TestExternalMatrix(t *testing.T) {
 
 }
 
+func TestInternalMatrix(t *testing.T) {
+	h := NewPermutation(4, 8, 56)
+	var tmp [4]fr.Element
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			tmp[j].SetUint64(0)
+			if i == j {
+				tmp[j].SetOne()
+			}
+		}
+		h.matMulInternalInPlace(tmp[:])
+		// column i of J+diag(diag4)
+		for j := 0; j < 4; j++ {
+			var expected fr.Element
+			expected.SetOne()
+			if i == j {
+				expected.Add(&expected, &diag4[i])
+			}
+			if !tmp[j].Equal(&expected) {
+				t.Fatal("error matMulInternal")
+			}
+		}
+	}
+}
+
+func TestPermutationWidth(t *testing.T) {
+	assert := require.New(t)
+	for width := 2; width <= 4; width++ {
+		h := NewPermutation(width, 8, 56)
+		assert.NoError(h.Permutation(make([]fr.Element, width)))
+		assert.ErrorIs(h.Permutation(make([]fr.Element, width+1)), ErrInvalidSizebuffer)
+	}
+	assert.Panics(func() { NewPermutation(1, 8, 56) })
+	assert.Panics(func() { NewPermutation(5, 8, 56) })
+}
+
 func BenchmarkPoseidon2(b *testing.B) {
 	h := NewPermutation(3, 8, 56)
 	var tmp [3]fr.Element
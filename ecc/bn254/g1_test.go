@@ -13,7 +13,6 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
-
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 
 	"github.com/leanovate/gopter"
@@ -44,6 +43,18 @@ func TestG1Endomorphism(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[BN254] check that Phi(P) = lambdaGLV * P in affine coordinates", prop.ForAll(
+		func(a fp.Element) bool {
+			var res1, res2 G1Affine
+			g := MapToG1(a)
+			res1.Phi(&g)
+			res2.ScalarMultiplication(&g, &lambdaGLV)
+
+			return res1.Equal(&res2) && res1.IsOnCurve()
+		},
+		GenFp(),
+	))
+
 	properties.Property("[BN254] check that phi^2(P) + phi(P) + P = 0", prop.ForAll(
 		func(a fp.Element) bool {
 			var p, res, tmp G1Jac
@@ -160,6 +171,53 @@ func TestIsInSubGroupBatchG1(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1Generator(t *testing.T) {
+	t.Parallel()
+
+	g := G1Generator()
+	if !g.Equal(&g1GenAff) {
+		t.Fatal("G1Generator should return the generator")
+	}
+
+	// mutating the returned point must not affect subsequent calls
+	g.Double(&g)
+	g.X.SetOne()
+	g2 := G1Generator()
+	if !g2.Equal(&g1GenAff) || !g2.IsInSubGroup() {
+		t.Fatal("G1Generator should return a copy of the generator")
+	}
+}
+
+func TestG1AffineRand(t *testing.T) {
+	t.Parallel()
+
+	var seed [32]byte
+	rng := rand.NewChaCha8(seed)
+
+	var p, q G1Affine
+	if _, err := p.Rand(rng); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Rand(rng); err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsInSubGroup() || !q.IsInSubGroup() {
+		t.Fatal("random point should be in the subgroup")
+	}
+	if p.Equal(&q) {
+		t.Fatal("two random points should differ")
+	}
+
+	// same seed, same point
+	var r G1Affine
+	if _, err := r.Rand(rand.NewChaCha8(seed)); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(&p) {
+		t.Fatal("random point should be deterministic given the rng state")
+	}
+}
+
 func TestG1Conversions(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -261,6 +319,32 @@ func TestG1Conversions(t *testing.T) {
 		GenFp(),
 		GenFp(),
 	))
+
+	properties.Property("[BN254] [Jacobian] Representatives of distinct points should not be equal", prop.ForAll(
+		func(a, b fp.Element) bool {
+			var neg, inf G1Jac
+			neg.Neg(&g1Gen)
+			op1 := fuzzG1Jac(&g1Gen, a)
+			op2 := fuzzG1Jac(&neg, b)
+			inf.Set(&op2)
+			inf.Z.SetZero()
+			return !op1.Equal(&op2) && !op2.Equal(&op1) && !op1.Equal(&inf) && !inf.Equal(&op1)
+		},
+		GenFp(),
+		GenFp(),
+	))
+
+	properties.Property("[BN254] [Jacobian] Representatives of infinity should be equal", prop.ForAll(
+		func(a, b fp.Element) bool {
+			var op1, op2 G1Jac
+			op1.X, op1.Y = a, b
+			op2.X.SetOne()
+			op2.Y.SetOne()
+			return op1.Equal(&op2) && op2.Equal(&op1)
+		},
+		GenFp(),
+		GenFp(),
+	))
 	properties.Property("[BN254] BatchJacobianToAffineG1 and FromJacobian should output the same result", prop.ForAll(
 		func(a, b fp.Element) bool {
 			g1 := fuzzG1Jac(&g1Gen, a)
@@ -356,6 +440,7 @@ func TestG1AffineOps(t *testing.T) {
 		func(s fr.Element) bool {
 			g := g1GenAff
 			var gj G1Jac
+			gj.FromAffine(&g)
 			var nbs, bs big.Int
 			s.BigInt(&bs)
 			nbs.Neg(&bs)
@@ -383,6 +468,48 @@ func TestG1AffineOps(t *testing.T) {
 		GenFr(),
 	))
 
+	properties.Property("[BN254] [-s]G = -[|s| mod r]G for scalars larger than r", prop.ForAll(
+		func(s, k fr.Element) bool {
+			g := g1GenAff
+			var gj G1Jac
+			gj.FromAffine(&g)
+
+			// bs = s + (k+1)·r·2¹²⁸, so that |bs| > r and |bs| mod r = s
+			var bs, nbs, kr big.Int
+			s.BigInt(&bs)
+			k.BigInt(&kr)
+			kr.Add(&kr, big.NewInt(1)).Mul(&kr, fr.Modulus()).Lsh(&kr, 128)
+			bs.Add(&bs, &kr)
+			nbs.Neg(&bs)
+
+			var expected G1Affine
+			expected.ScalarMultiplication(&g, s.BigInt(new(big.Int))).Neg(&expected)
+
+			var op1 G1Affine
+			var op2 G1Jac
+			op1.ScalarMultiplication(&g, &nbs)
+			op2.ScalarMultiplication(&gj, &nbs)
+			var op2Aff G1Affine
+			op2Aff.FromJacobian(&op2)
+			return op1.Equal(&expected) && op2Aff.Equal(&expected)
+		},
+		GenFr(),
+		GenFr(),
+	))
+
+	properties.Property("[BN254] [0]G and [±r]G should be infinity", prop.ForAll(
+		func() bool {
+			g := g1GenAff
+			var nr big.Int
+			nr.Neg(fr.Modulus())
+			var op1, op2, op3 G1Affine
+			op1.ScalarMultiplication(&g, big.NewInt(0))
+			op2.ScalarMultiplication(&g, fr.Modulus())
+			op3.ScalarMultiplication(&g, &nr)
+			return op1.IsInfinity() && op2.IsInfinity() && op3.IsInfinity()
+		},
+	))
+
 	properties.Property("[BN254] [Jacobian] Add should call double when adding the same point", prop.ForAll(
 		func(a, b fp.Element) bool {
 			fop1 := fuzzG1Jac(&g1Gen, a)
@@ -547,9 +674,185 @@ func TestG1AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BN254] ScalarMulXOnly should output the x-coordinate of ScalarMultiplication", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var base, op1, op2 G1Affine
+			var sInt big.Int
+			base.ScalarMultiplication(&g1GenAff, big.NewInt(7))
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&base, &sInt)
+			x := op2.ScalarMulXOnly(base, &s)
+
+			// [0]base is the point at infinity, encoded as (0,0)
+			var zero fr.Element
+			var infinity G1Affine
+			x0 := op2.ScalarMulXOnly(base, &zero)
+
+			return x.Equal(&op1.X) && op2.Equal(&infinity) && x0.IsZero()
+
+		},
+		genScalar,
+	))
+
+	properties.Property("[BN254] ScalarMultiplicationConstantTime should output the same result as ScalarMultiplication", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var base, op1, op2, op3, infinity G1Affine
+			var sInt big.Int
+			base.ScalarMultiplication(&g1GenAff, big.NewInt(7))
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&base, &sInt)
+			op2.ScalarMultiplicationConstantTime(&base, &sInt)
+
+			// s, s+r and s-r, [0]base and [s]infinity
+			sInt.Add(&sInt, fr.Modulus())
+			op3.ScalarMultiplicationConstantTime(&base, &sInt)
+			ok := op1.Equal(&op2) && op1.Equal(&op3)
+			sInt.Sub(&sInt, fr.Modulus()).Sub(&sInt, fr.Modulus())
+			op3.ScalarMultiplicationConstantTime(&base, &sInt)
+			ok = ok && op1.Equal(&op3)
+			op3.ScalarMultiplicationConstantTime(&base, big.NewInt(0))
+			ok = ok && op3.Equal(&infinity)
+			op3.ScalarMultiplicationConstantTime(&infinity, &sInt)
+			return ok && op3.Equal(&infinity)
+
+		},
+		genScalar,
+	))
+
+	properties.Property("[BN254] ScalarMultiplicationWithWindow should output the same result as ScalarMultiplication for any window", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var base, op1, op2 G1Affine
+			var sInt big.Int
+			base.ScalarMultiplication(&g1GenAff, big.NewInt(7))
+			s.BigInt(&sInt)
+			op1.ScalarMultiplication(&base, &sInt)
+
+			// out of range windows are clamped, 0 is auto
+			for window := -1; window <= 9; window++ {
+				op2.ScalarMultiplicationWithWindow(&base, &sInt, window)
+				if !op1.Equal(&op2) {
+					return false
+				}
+			}
+
+			// negative and small scalars
+			sInt.Neg(&sInt)
+			op1.ScalarMultiplication(&base, &sInt)
+			op2.ScalarMultiplicationWithWindow(&base, &sInt, 3)
+			if !op1.Equal(&op2) {
+				return false
+			}
+			sInt.Rsh(&sInt, fr.Bits/2)
+			op1.ScalarMultiplication(&base, &sInt)
+			op2.ScalarMultiplicationWithWindow(&base, &sInt, 6)
+			return op1.Equal(&op2)
+
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestBatchNegG1(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	properties.Property("[BN254] BatchNeg and BatchNegated should match Neg, and leave infinity unchanged", prop.ForAll(
+		func(s1, s2 fr.Element) bool {
+			var sInt big.Int
+			points := make([]G1Affine, 3)
+			points[0].ScalarMultiplication(&g1GenAff, s1.BigInt(&sInt))
+			points[1].SetInfinity()
+			points[2].ScalarMultiplication(&g1GenAff, s2.BigInt(&sInt))
+
+			expected := make([]G1Affine, len(points))
+			for i := range points {
+				expected[i].Neg(&points[i])
+			}
+
+			input := make([]G1Affine, len(points))
+			copy(input, points)
+			negated := BatchNegatedG1(points)
+			for i := range points {
+				// BatchNegated must not modify its input
+				if !points[i].Equal(&input[i]) || !negated[i].Equal(&expected[i]) {
+					return false
+				}
+			}
+
+			BatchNegG1(points)
+			for i := range points {
+				if !points[i].Equal(&expected[i]) {
+					return false
+				}
+			}
+			return points[1].IsInfinity() && negated[1].IsInfinity()
+		},
+		genScalar,
+		genScalar,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestBatchSumG1(t *testing.T) {
+	t.Parallel()
+
+	naiveSum := func(points []G1Affine) G1Jac {
+		var res G1Jac
+		res.Set(&g1Infinity)
+		for i := range points {
+			res.AddMixed(&points[i])
+		}
+		return res
+	}
+
+	// random points, plus points taken among a few multiples of the generator
+	// and their negations so that the pairwise sums hit doublings and P + (-P)
+	var small [3]G1Affine
+	for i := range small {
+		small[i].ScalarMultiplication(&g1GenAff, big.NewInt(int64(i+1)))
+	}
+	for _, n := range []int{0, 1, 2, 15, 16, 17, 31, 32, 100, 1025} {
+		for _, tricky := range []bool{false, true} {
+			points := make([]G1Affine, n)
+			for i := range points {
+				switch {
+				case !tricky:
+					var s fr.Element
+					s.MustSetRandom()
+					points[i].ScalarMultiplication(&g1GenAff, s.BigInt(new(big.Int)))
+				case i%7 == 6:
+					points[i].SetInfinity()
+				case i%2 == 0:
+					points[i] = small[(i/2)%len(small)]
+				default:
+					points[i].Neg(&small[(i/3)%len(small)])
+				}
+			}
+
+			expected := naiveSum(points)
+			res := BatchSumG1(points)
+			if !res.Equal(&expected) {
+				t.Fatalf("BatchSumG1 of %d points (tricky: %v) doesn't match the naive sum", n, tricky)
+			}
+		}
+	}
+}
+
 func TestG1BatchScalarMultiplication(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
@@ -600,6 +903,69 @@ func TestG1BatchScalarMultiplication(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestFixedBaseBGMWG1(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	var base G1Affine
+	base.ScalarMultiplication(&g1GenAff, big.NewInt(7))
+
+	if _, err := NewFixedBaseBGMWG1(base, 1, 1); err == nil {
+		t.Fatal("expected an error for h = 1")
+	}
+	if _, err := NewFixedBaseBGMWG1(base, 4, 0); err == nil {
+		t.Fatal("expected an error for v = 0")
+	}
+
+	nbChunks := int(computeNbChunks(5))
+	params := [][2]int{{2, 1}, {4, 1}, {5, nbChunks}, {8, 3}, {15, 2}}
+	tables := make([]*FixedBaseBGMWG1, len(params))
+	for i, hv := range params {
+		var err error
+		if tables[i], err = NewFixedBaseBGMWG1(base, hv[0], hv[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	properties.Property("[BN254] FixedBaseBGMW ScalarMul and BatchScalarMul should match ScalarMultiplication", prop.ForAll(
+		func(s fr.Element) bool {
+			var minusOne fr.Element
+			minusOne.SetOne().Neg(&minusOne)
+			scalars := []fr.Element{s, {}, minusOne}
+
+			expected := make([]G1Affine, len(scalars))
+			for i := range scalars {
+				var sInt big.Int
+				expected[i].ScalarMultiplication(&base, scalars[i].BigInt(&sInt))
+			}
+
+			for _, table := range tables {
+				batch := table.BatchScalarMul(scalars)
+				for i := range scalars {
+					var sInt big.Int
+					r := table.ScalarMul(scalars[i].BigInt(&sInt))
+					if !r.Equal(&expected[i]) || !batch[i].Equal(&expected[i]) {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func TestG1JacTriple(t *testing.T) {
 	// test triple on the generator and the infinity point
 	// against double and add
@@ -625,6 +991,163 @@ func TestG1JacTriple(t *testing.T) {
 	}
 }
 
+func TestG1JacAddComplete(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	var infinity G1Jac
+	infinity.Set(&g1Infinity)
+
+	// addComplete returns a + b with AddComplete, checking the aliasing of
+	// the result with the inputs.
+	addComplete := func(a, b *G1Jac) (G1Jac, bool) {
+		var res, resA, resB G1Jac
+		res.AddComplete(a, b)
+		resA.Set(a)
+		resA.AddComplete(&resA, b)
+		resB.Set(b)
+		resB.AddComplete(a, &resB)
+		return res, res.Equal(&resA) && res.Equal(&resB)
+	}
+
+	properties.Property("[BN254] AddComplete should match AddAssign", prop.ForAll(
+		func(s1, s2 fr.Element, f1, f2 fp.Element) bool {
+			var a, b, expected G1Jac
+			a.ScalarMultiplication(&g1Gen, s1.BigInt(new(big.Int)))
+			b.ScalarMultiplication(&g1Gen, s2.BigInt(new(big.Int)))
+			a = fuzzG1Jac(&a, f1)
+			b = fuzzG1Jac(&b, f2)
+			expected.Set(&a).AddAssign(&b)
+			res, ok := addComplete(&a, &b)
+			return ok && res.Equal(&expected) && res.IsOnCurve()
+		},
+		GenFr(),
+		GenFr(),
+		GenFp(),
+		GenFp(),
+	))
+
+	properties.Property("[BN254] AddComplete should double P + P", prop.ForAll(
+		func(s fr.Element, f1, f2 fp.Element) bool {
+			var a, expected G1Jac
+			a.ScalarMultiplication(&g1Gen, s.BigInt(new(big.Int)))
+			expected.Double(&a)
+			// same point, different representations
+			a1 := fuzzG1Jac(&a, f1)
+			a2 := fuzzG1Jac(&a, f2)
+			res, ok := addComplete(&a1, &a2)
+			if !ok || !res.Equal(&expected) {
+				return false
+			}
+			res, ok = addComplete(&a1, &a1)
+			return ok && res.Equal(&expected)
+		},
+		GenFr(),
+		GenFp(),
+		GenFp(),
+	))
+
+	properties.Property("[BN254] AddComplete of P and -P should be the point at infinity", prop.ForAll(
+		func(s fr.Element, f1, f2 fp.Element) bool {
+			var a, aNeg G1Jac
+			a.ScalarMultiplication(&g1Gen, s.BigInt(new(big.Int)))
+			aNeg.Neg(&a)
+			a = fuzzG1Jac(&a, f1)
+			aNeg = fuzzG1Jac(&aNeg, f2)
+			res, ok := addComplete(&a, &aNeg)
+			return ok && res.Z.IsZero() && res.Equal(&infinity) && res.X.IsOne() && res.Y.IsOne()
+		},
+		GenFr(),
+		GenFp(),
+		GenFp(),
+	))
+
+	properties.Property("[BN254] AddComplete with the point at infinity should be the identity", prop.ForAll(
+		func(s fr.Element, f1, f2 fp.Element) bool {
+			var a G1Jac
+			a.ScalarMultiplication(&g1Gen, s.BigInt(new(big.Int)))
+			a = fuzzG1Jac(&a, f1)
+			inf := fuzzG1Jac(&infinity, f2)
+			r1, ok1 := addComplete(&a, &inf)
+			r2, ok2 := addComplete(&inf, &a)
+			r3, ok3 := addComplete(&inf, &inf)
+			ok := ok1 && ok2 && ok3 && r1.Equal(&a) && r2.Equal(&a) && r3.Equal(&infinity)
+
+			// the zero value (0, 0, 0) is also the point at infinity
+			var zero G1Jac
+			r1, ok1 = addComplete(&a, &zero)
+			r2, ok2 = addComplete(&zero, &a)
+			r3, ok3 = addComplete(&zero, &zero)
+			return ok && ok1 && ok2 && ok3 && r1.Equal(&a) && r2.Equal(&a) && r3.Equal(&infinity)
+		},
+		GenFr(),
+		GenFp(),
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG1AffineDoubleTriple(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BN254] affine Double and Triple should match the Jacobian ones", prop.ForAll(
+		func(s fr.Element) bool {
+			var a, double, triple, expected G1Affine
+			a.ScalarMultiplication(&g1GenAff, s.BigInt(new(big.Int)))
+			var j G1Jac
+			j.FromAffine(&a)
+
+			double.Double(&a)
+			var jd G1Jac
+			jd.Double(&j)
+			expected.FromJacobian(&jd)
+			if !double.Equal(&expected) {
+				return false
+			}
+
+			triple.Triple(&a)
+			var jt G1Jac
+			jt.Triple(&j)
+			expected.FromJacobian(&jt)
+			if !triple.Equal(&expected) {
+				return false
+			}
+
+			// in place
+			a.Triple(&a)
+			return a.Equal(&triple)
+		},
+		GenFr(),
+	))
+
+	properties.Property("[BN254] affine Double and Triple of infinity should be infinity", prop.ForAll(
+		func() bool {
+			var inf, double, triple G1Affine
+			double.Double(&inf)
+			triple.Triple(&inf)
+			return double.IsInfinity() && triple.IsInfinity()
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func BenchmarkG1JacTriple(b *testing.B) {
 	var a G1Jac
 	a.Set(&g1Gen)
@@ -716,6 +1239,39 @@ func BenchmarkBatchAddG1Affine(b *testing.B) {
 		batchAddG1Affine[pG1AffineC16, ppG1AffineC16, cG1AffineC16](&RR, &P, len(P))
 	}
 }
+func BenchmarkBatchSumG1(b *testing.B) {
+	const nbPoints = 1 << 16
+	scalars := make([]fr.Element, nbPoints)
+	for i := range scalars {
+		scalars[i].MustSetRandom()
+	}
+	points := BatchScalarMultiplicationG1(&g1GenAff, scalars)
+
+	b.Run("naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var res G1Jac
+			res.Set(&g1Infinity)
+			for j := range points {
+				res.AddMixed(&points[j])
+			}
+		}
+	})
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchSumG1(points)
+		}
+	})
+}
+
+func BenchmarkG1AffineRand(b *testing.B) {
+	var p G1Affine
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Rand(crand.Reader); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
 
 func BenchmarkG1AffineBatchScalarMultiplication(b *testing.B) {
 	// ensure every words of the scalars are filled
@@ -788,6 +1344,74 @@ func BenchmarkG1JacScalarMultiplicationMethod(b *testing.B) {
 		})
 	}
 }
+func BenchmarkFixedBaseBGMWG1(b *testing.B) {
+	const nbSamples = 1 << 12
+	var mixer fr.Element
+	mixer.SetString("7716837800905789770901243404444209691916730933998574719964609384059111546487")
+
+	var sampleScalars [nbSamples]fr.Element
+	for i := 1; i <= nbSamples; i++ {
+		sampleScalars[i-1].SetUint64(uint64(i)).
+			Mul(&sampleScalars[i-1], &mixer)
+	}
+
+	b.Run("BatchScalarMultiplication", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			_ = BatchScalarMultiplicationG1(&g1GenAff, sampleScalars[:])
+		}
+	})
+
+	for _, hv := range [][2]int{{3, 1}, {4, 1}, {5, 1}, {6, 1}, {4, 2}, {4, 4}} {
+		table, err := NewFixedBaseBGMWG1(g1GenAff, hv[0], hv[1])
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("BGMW/h=%d/v=%d/table=%d", hv[0], hv[1], len(table.table)), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				_ = table.BatchScalarMul(sampleScalars[:])
+			}
+		})
+	}
+}
+
+func BenchmarkG1AffineScalarMultiplicationConstantTime(b *testing.B) {
+	scalar, err := crand.Int(crand.Reader, fr.Modulus())
+	if err != nil {
+		b.Fatalf("failed to generate random scalar: %v", err)
+	}
+
+	var res G1Affine
+	b.Run("GLV", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ScalarMultiplication(&g1GenAff, scalar)
+		}
+	})
+	b.Run("constant-time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ScalarMultiplicationConstantTime(&g1GenAff, scalar)
+		}
+	})
+}
+
+func BenchmarkG1AffineScalarMultiplicationWithWindow(b *testing.B) {
+	var res G1Affine
+	for _, nbBits := range []int{128, fr.Bits} {
+		var scalar big.Int
+		scalar.Lsh(big.NewInt(1), uint(nbBits)).Sub(&scalar, big.NewInt(1))
+		scalar.Mod(&scalar, fr.Modulus())
+		for window := 2; window <= 8; window++ {
+			b.Run(fmt.Sprintf("%d-bit/window=%d", nbBits, window), func(b *testing.B) {
+				for j := 0; j < b.N; j++ {
+					res.ScalarMultiplicationWithWindow(&g1GenAff, &scalar, window)
+				}
+			})
+		}
+	}
+}
 
 func BenchmarkG1JacAdd(b *testing.B) {
 	var a G1Jac
@@ -798,6 +1422,15 @@ func BenchmarkG1JacAdd(b *testing.B) {
 	}
 }
 
+func BenchmarkG1JacAddComplete(b *testing.B) {
+	var a G1Jac
+	a.Double(&g1Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.AddComplete(&a, &g1Gen)
+	}
+}
+
 func BenchmarkG1JacAddMixed(b *testing.B) {
 	var a G1Jac
 	a.Double(&g1Gen)
@@ -8,8 +8,11 @@ package bn254
 import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 
+	"bytes"
 	"math/rand"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
@@ -67,6 +70,8 @@ func TestMapToCurve1(t *testing.T) {
 		g1CoordSetString(&u, c.u)
 		q := MapToCurve1(&u)
 		g1TestMatchPoint(t, "Q", c.msg, c.Q, &q)
+		q = MapToCurveG1(&u)
+		g1TestMatchPoint(t, "Q", c.msg, c.Q, &q)
 	}
 
 	for _, c := range hashToG1Vector.cases {
@@ -100,6 +105,23 @@ func TestMapToG1(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G1] MapToCurveG1 should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG1(&a)
+			return g.IsOnCurve()
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] MapToG1 should be MapToCurveG1 followed by cofactor clearing", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG1(&a)
+			h := MapToG1(a)
+			return g.Equal(&h)
+		},
+		GenFp(),
+	))
+
 	properties.Property("[G1] mapping to curve should be deterministic", prop.ForAll(
 		func(a fp.Element) bool {
 			g1 := MapToG1(a)
@@ -134,6 +156,33 @@ func TestHashToG1(t *testing.T) {
 	}
 }
 
+func TestHashToG1Reader(t *testing.T) {
+	t.Parallel()
+	for _, c := range hashToG1Vector.cases {
+		p, err := HashToG1Reader(strings.NewReader(c.msg), hashToG1Vector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g1TestMatchPoint(t, "P", c.msg, c.P, &p)
+	}
+
+	// large message read in small chunks, with a DST longer than 255 bytes
+	msg := bytes.Repeat([]byte("gnark-crypto"), 1<<17)
+	for _, dst := range [][]byte{hashToG1Vector.dst, bytes.Repeat([]byte("long-DST-"), 50)} {
+		expected, err := HashToG1(msg, dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := HashToG1Reader(iotest.HalfReader(bytes.NewReader(msg)), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&expected) {
+			t.Fatalf("streamed hash (dst length %d) differs from HashToG1", len(dst))
+		}
+	}
+}
+
 func BenchmarkEncodeToG1(b *testing.B) {
 	const size = 54
 	bytes := make([]byte, size)
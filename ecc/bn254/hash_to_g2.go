@@ -6,6 +6,8 @@
 package bn254
 
 import (
+	"io"
+
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 	"github.com/consensys/gnark-crypto/ecc/bn254/hash_to_curve"
 	"github.com/consensys/gnark-crypto/ecc/bn254/internal/fptower"
@@ -18,6 +20,16 @@ func MapToG2(u fptower.E2) G2Affine {
 	return res
 }
 
+// MapToCurveG2 is the map_to_curve step of RFC 9380: it maps u to a point on the curve using the
+// SVDW map.
+// The result is not guaranteed to be in G2; use [MapToG2] to also clear the cofactor.
+//
+// See https://www.rfc-editor.org/rfc/rfc9380.html#section-6
+func MapToCurveG2(u *fptower.E2) G2Affine {
+	res := MapToCurve2(u)
+	return res
+}
+
 // EncodeToG2 hashes a message to a point on the G2 curve using the SVDW map.
 // It is faster than [HashToG2], but the result is not uniformly distributed. Unsuitable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
@@ -50,7 +62,24 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	if err != nil {
 		return G2Affine{}, err
 	}
+	return hashToG2(u), nil
+}
+
+// HashToG2Reader is [HashToG2] for a message read from r until io.EOF.
+//
+// The message is streamed through expand_message_xmd and never held in memory, so that it can be
+// arbitrarily large. As in [HashToG2], domain separation tags longer than 255 bytes are
+// first hashed down as specified in RFC 9380 section 5.3.3.
+func HashToG2Reader(r io.Reader, dst []byte) (G2Affine, error) {
+	u, err := fp.HashReader(r, dst, 2*2)
+	if err != nil {
+		return G2Affine{}, err
+	}
+	return hashToG2(u), nil
+}
 
+// hashToG2 maps the 2 field elements u obtained by hash_to_field to a point in G2.
+func hashToG2(u []fp.Element) G2Affine {
 	Q0 := MapToCurve2(&fptower.E2{
 		A0: u[0],
 		A1: u[1],
@@ -67,7 +96,7 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	_Q1.ClearCofactor(&_Q1)
 
 	Q1.FromJacobian(&_Q1)
-	return Q1, nil
+	return Q1
 }
 
 // MapToCurve2 implements the Shallue and van de Woestijne method, applicable to any elliptic curve in Weierstrass form.
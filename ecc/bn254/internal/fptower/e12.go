@@ -6,7 +6,9 @@
 package fptower
 
 import (
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 	"sync"
 
@@ -43,6 +45,20 @@ func (z *E12) SetString(s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11 string)
 	return z
 }
 
+// SetStringChecked sets a E12 from strings, as SetString, but returns an error
+// if a string is not a valid number. In that case, z is left unchanged.
+func (z *E12) SetStringChecked(s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11 string) (*E12, error) {
+	var c0, c1 E6
+	if _, err := c0.SetStringChecked(s0, s1, s2, s3, s4, s5); err != nil {
+		return nil, err
+	}
+	if _, err := c1.SetStringChecked(s6, s7, s8, s9, s10, s11); err != nil {
+		return nil, err
+	}
+	z.C0, z.C1 = c0, c1
+	return z, nil
+}
+
 // Set copies x into z and returns z
 func (z *E12) Set(x *E12) *E12 {
 	z.C0 = x.C0
@@ -742,17 +758,74 @@ func (z *E12) IsInSubGroup() bool {
 	return a.Equal(&b)
 }
 
+// isInCyclotomicSubgroup returns true if z^(p⁴-p²+1) == 1, i.e. if
+// z^(p⁴)·z == z^(p²).
+func (z *E12) isInCyclotomicSubgroup() bool {
+	var a, b E12
+	a.FrobeniusSquare(z)
+	b.FrobeniusSquare(&a).Mul(&b, z)
+	return a.Equal(&b)
+}
+
+// gtHardPartExponent is (p⁴-p²+1)/r, the exponent of the hard part of the
+// map from E12* onto GT.
+var gtHardPartExponent = sync.OnceValue(func() *big.Int {
+	p := fp.Modulus()
+	p2 := new(big.Int).Mul(p, p)
+	e := new(big.Int).Mul(p2, p2)
+	e.Sub(e, p2).Add(e, big.NewInt(1))
+	return e.Div(e, fr.Modulus())
+})
+
+// Rand sets z to a random element of GT, the order-r subgroup of E12*, using
+// randomness from rng, and returns z.
+//
+// A random E12 is not in GT in general; it is raised to the power (p¹²-1)/r,
+// which maps E12* uniformly onto GT.
+func (z *E12) Rand(rng io.Reader) (*E12, error) {
+	var x E12
+	coords := [12]*fp.Element{
+		&x.C0.B0.A0, &x.C0.B0.A1, &x.C0.B1.A0, &x.C0.B1.A1, &x.C0.B2.A0, &x.C0.B2.A1,
+		&x.C1.B0.A0, &x.C1.B0.A1, &x.C1.B1.A0, &x.C1.B1.A1, &x.C1.B2.A0, &x.C1.B2.A1,
+	}
+	for x.IsZero() {
+		for _, c := range coords {
+			v, err := rand.Int(rng, fp.Modulus())
+			if err != nil {
+				return nil, err
+			}
+			c.SetBigInt(v)
+		}
+	}
+
+	// easy part: x^((p⁶-1)(p²+1))
+	var t E12
+	t.Conjugate(&x)
+	x.Inverse(&x)
+	t.Mul(&t, &x)
+	x.FrobeniusSquare(&t).Mul(&x, &t)
+
+	// hard part: x^((p⁴-p²+1)/r)
+	z.CyclotomicExp(x, gtHardPartExponent())
+	return z, nil
+}
+
 // CompressTorus GT/E12 element to half its size
 // z must be in the cyclotomic subgroup
 // i.e. z^(p^4-p^2+1)=1
 // e.g. GT
 // "COMPRESSION IN FINITE FIELDS AND TORUS-BASED CRYPTOGRAPHY", K. RUBIN AND A. SILVERBERG
 // z.C1 == 0 only when z \in {-1,1}
+//
+// It returns an error if z is not in the cyclotomic subgroup or if z.C1 == 0.
 func (z *E12) CompressTorus() (E6, error) {
 
 	if z.C1.IsZero() {
 		return E6{}, errors.New("invalid input")
 	}
+	if !z.isInCyclotomicSubgroup() {
+		return E6{}, errors.New("invalid input; not in the cyclotomic subgroup")
+	}
 
 	var res, tmp, one E6
 	one.SetOne()
@@ -765,7 +838,8 @@ func (z *E12) CompressTorus() (E6, error) {
 
 // BatchCompressTorus GT/E12 elements to half their size using a batch inversion.
 //
-// if len(x) == 0 or if any of the x[i].C1 coordinate is 0, this function returns an error.
+// if len(x) == 0, if any of the x[i].C1 coordinate is 0 or if any of the x[i]
+// is not in the cyclotomic subgroup, this function returns an error.
 func BatchCompressTorus(x []E12) ([]E6, error) {
 
 	n := len(x)
@@ -783,6 +857,9 @@ func BatchCompressTorus(x []E12) ([]E6, error) {
 		if res[i].IsZero() {
 			return nil, errors.New("invalid input; C1 is 0")
 		}
+		if !x[i].isInCyclotomicSubgroup() {
+			return nil, errors.New("invalid input; not in the cyclotomic subgroup")
+		}
 	}
 
 	t := BatchInvertE6(res) // costs 1 inverse
@@ -852,6 +929,8 @@ func (z *E12) Select(cond int, caseZ *E12, caseNz *E12) *E12 {
 }
 
 // Div divides an element in E12 by an element in E12
+//
+// if y == 0, sets and returns z = 0 (as Inverse maps 0 to 0)
 func (z *E12) Div(x *E12, y *E12) *E12 {
 	var r E12
 	r.Inverse(y).Mul(x, &r)
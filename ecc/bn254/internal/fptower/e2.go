@@ -59,6 +59,21 @@ func (z *E2) SetString(s1, s2 string) *E2 {
 	return z
 }
 
+// SetStringChecked sets a E2 element from strings, as SetString, but returns an error
+// if a string is not a valid number (in decimal, or with a 0x, 0o or 0b prefix).
+// In that case, z is left unchanged.
+func (z *E2) SetStringChecked(s1, s2 string) (*E2, error) {
+	var a0, a1 fp.Element
+	if _, err := a0.SetString(s1); err != nil {
+		return nil, err
+	}
+	if _, err := a1.SetString(s2); err != nil {
+		return nil, err
+	}
+	z.A0, z.A1 = a0, a1
+	return z, nil
+}
+
 // SetZero sets an E2 elmt to zero
 func (z *E2) SetZero() *E2 {
 	z.A0.SetZero()
@@ -295,6 +310,8 @@ func (z *E2) Select(cond int, caseZ *E2, caseNz *E2) *E2 {
 }
 
 // Div divides an element in E2 by an element in E2
+//
+// if y == 0, sets and returns z = 0 (as Inverse maps 0 to 0)
 func (z *E2) Div(x *E2, y *E2) *E2 {
 	var r E2
 	r.Inverse(y).Mul(x, &r)
@@ -6,6 +6,9 @@
 package kzg
 
 import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
 	"io"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -13,6 +16,44 @@ import (
 	"github.com/consensys/gnark-crypto/utils/unsafe"
 )
 
+// encodingVersion is the version of the format written by the SRS, OpeningProof
+// and Digest encoders.
+const encodingVersion uint16 = 1
+
+// headerSize is the size of the header prefixing the SRS, OpeningProof and Digest
+// encodings: 4 magic bytes, the version and the curve id as big-endian uint16.
+const headerSize = 8
+
+var headerMagic = [4]byte{'K', 'Z', 'G', 0}
+
+func writeHeader(w io.Writer) (int64, error) {
+	var buf [headerSize]byte
+	copy(buf[:4], headerMagic[:])
+	binary.BigEndian.PutUint16(buf[4:6], encodingVersion)
+	binary.BigEndian.PutUint16(buf[6:8], uint16(bn254.ID))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// readHeader reads and checks the header written by writeHeader.
+func readHeader(r io.Reader) (int64, error) {
+	var buf [headerSize]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	if !bytes.Equal(buf[:4], headerMagic[:]) {
+		return int64(n), ErrInvalidHeader
+	}
+	if version := binary.BigEndian.Uint16(buf[4:6]); version != encodingVersion {
+		return int64(n), fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	if id := binary.BigEndian.Uint16(buf[6:8]); id != uint16(bn254.ID) {
+		return int64(n), fmt.Errorf("%w: got curve id %d, expected %d (%s)", ErrCurveMismatch, id, uint16(bn254.ID), bn254.ID)
+	}
+	return int64(n), nil
+}
+
 // WriteTo writes binary encoding of the ProvingKey
 func (pk *ProvingKey) WriteTo(w io.Writer) (int64, error) {
 	return pk.writeTo(w)
@@ -113,28 +154,36 @@ func (srs *SRS) ReadDump(r io.Reader, maxPkPoints ...int) error {
 	return err
 }
 
-// WriteTo writes binary encoding of the entire SRS
+// WriteTo writes binary encoding of the entire SRS, prefixed with a header
+// holding the encoding version and the curve id.
 func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
 	// encode the SRS
-	var pn, vn int64
+	var hn, pn, vn int64
 	var err error
+	if hn, err = writeHeader(w); err != nil {
+		return hn, err
+	}
 	if pn, err = srs.Pk.WriteTo(w); err != nil {
-		return pn, err
+		return hn + pn, err
 	}
 	vn, err = srs.Vk.WriteTo(w)
-	return pn + vn, err
+	return hn + pn + vn, err
 }
 
-// WriteRawTo writes binary encoding of the entire SRS without point compression
+// WriteRawTo writes binary encoding of the entire SRS without point compression,
+// prefixed with a header holding the encoding version and the curve id.
 func (srs *SRS) WriteRawTo(w io.Writer) (int64, error) {
 	// encode the SRS
-	var pn, vn int64
+	var hn, pn, vn int64
 	var err error
+	if hn, err = writeHeader(w); err != nil {
+		return hn, err
+	}
 	if pn, err = srs.Pk.WriteRawTo(w); err != nil {
-		return pn, err
+		return hn + pn, err
 	}
 	vn, err = srs.Vk.WriteRawTo(w)
-	return pn + vn, err
+	return hn + pn + vn, err
 }
 
 // ReadFrom decodes ProvingKey data from reader.
@@ -158,6 +207,41 @@ func (pk *ProvingKey) UnsafeReadFrom(r io.Reader) (int64, error) {
 	return dec.BytesRead(), nil
 }
 
+// ReadFromStream decodes the first n points of a ProvingKey, as written by
+// WriteTo or WriteRawTo, and calls visit on each of them in order. Unlike
+// ReadFrom, the points are decoded one at a time and are not held in memory,
+// so that very large keys can be loaded into custom storage.
+//
+// The points are checked to be in the correct subgroup, unless
+// bn254.NoSubgroupChecks() is given in options. It returns
+// ErrNotEnoughPoints if the encoded key holds fewer than n points, and stops
+// at the first error returned by visit. The remaining points, if any, are not
+// read from r.
+func ReadFromStream(r io.Reader, n uint64, visit func(i uint64, p bn254.G1Affine) error, options ...func(*bn254.Decoder)) (int64, error) {
+	// read the number of points
+	var buf [4]byte
+	read, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(read), err
+	}
+	if uint64(binary.BigEndian.Uint32(buf[:])) < n {
+		return int64(read), ErrNotEnoughPoints
+	}
+
+	// decode the points one by one
+	dec := bn254.NewDecoder(r, options...)
+	var p bn254.G1Affine
+	for i := uint64(0); i < n; i++ {
+		if err := dec.Decode(&p); err != nil {
+			return int64(read) + dec.BytesRead(), err
+		}
+		if err := visit(i, p); err != nil {
+			return int64(read) + dec.BytesRead(), err
+		}
+	}
+	return int64(read) + dec.BytesRead(), nil
+}
+
 // ReadFrom decodes VerifyingKey data from reader.
 func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
 	// decode the VerifyingKey
@@ -185,8 +269,30 @@ func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
 	return dec.BytesRead(), nil
 }
 
-// ReadFrom decodes SRS data from reader.
+// ReadFrom decodes SRS data from reader, as written by WriteTo or WriteRawTo.
+// It returns an error if the header is not for this curve or for a known version.
 func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
+	hn, err := readHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	n, err := srs.ReadFromLegacy(r)
+	return hn + n, err
+}
+
+// UnsafeReadFrom decodes SRS data from reader without sub group checks
+func (srs *SRS) UnsafeReadFrom(r io.Reader) (int64, error) {
+	hn, err := readHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	n, err := srs.UnsafeReadFromLegacy(r)
+	return hn + n, err
+}
+
+// ReadFromLegacy decodes SRS data from reader in the headerless format, that is
+// the encoding of the ProvingKey followed by the encoding of the VerifyingKey.
+func (srs *SRS) ReadFromLegacy(r io.Reader) (int64, error) {
 	// decode the VerifyingKey
 	var pn, vn int64
 	var err error
@@ -197,8 +303,9 @@ func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
 	return pn + vn, err
 }
 
-// UnsafeReadFrom decodes SRS data from reader without sub group checks
-func (srs *SRS) UnsafeReadFrom(r io.Reader) (int64, error) {
+// UnsafeReadFromLegacy decodes SRS data from reader in the headerless format
+// without sub group checks
+func (srs *SRS) UnsafeReadFromLegacy(r io.Reader) (int64, error) {
 	// decode the VerifyingKey
 	var pn, vn int64
 	var err error
@@ -209,8 +316,45 @@ func (srs *SRS) UnsafeReadFrom(r io.Reader) (int64, error) {
 	return pn + vn, err
 }
 
-// WriteTo writes binary encoding of a OpeningProof
+// WriteDigestTo writes the binary encoding of a Digest, prefixed with a header
+// holding the encoding version and the curve id.
+func WriteDigestTo(w io.Writer, digest *Digest) (int64, error) {
+	hn, err := writeHeader(w)
+	if err != nil {
+		return hn, err
+	}
+	enc := bn254.NewEncoder(w)
+	err = enc.Encode(digest)
+	return hn + enc.BytesWritten(), err
+}
+
+// ReadDigestFrom decodes a Digest as written by WriteDigestTo. It returns an
+// error if the header is not for this curve or for a known version.
+func ReadDigestFrom(r io.Reader, digest *Digest) (int64, error) {
+	hn, err := readHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	n, err := ReadDigestFromLegacy(r, digest)
+	return hn + n, err
+}
+
+// ReadDigestFromLegacy decodes a Digest in the headerless format, that is the
+// encoding of the underlying point.
+func ReadDigestFromLegacy(r io.Reader, digest *Digest) (int64, error) {
+	dec := bn254.NewDecoder(r)
+	err := dec.Decode(digest)
+	return dec.BytesRead(), err
+}
+
+// WriteTo writes binary encoding of a OpeningProof, prefixed with a header
+// holding the encoding version and the curve id.
 func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	hn, err := writeHeader(w)
+	if err != nil {
+		return hn, err
+	}
+
 	enc := bn254.NewEncoder(w)
 
 	toEncode := []interface{}{
@@ -220,15 +364,26 @@ func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
 
 	for _, v := range toEncode {
 		if err := enc.Encode(v); err != nil {
-			return enc.BytesWritten(), err
+			return hn + enc.BytesWritten(), err
 		}
 	}
 
-	return enc.BytesWritten(), nil
+	return hn + enc.BytesWritten(), nil
 }
 
-// ReadFrom decodes OpeningProof data from reader.
+// ReadFrom decodes OpeningProof data from reader, as written by WriteTo.
+// It returns an error if the header is not for this curve or for a known version.
 func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	hn, err := readHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	n, err := proof.ReadFromLegacy(r)
+	return hn + n, err
+}
+
+// ReadFromLegacy decodes OpeningProof data from reader in the headerless format.
+func (proof *OpeningProof) ReadFromLegacy(r io.Reader) (int64, error) {
 	dec := bn254.NewDecoder(r)
 
 	toDecode := []interface{}{
@@ -279,3 +434,40 @@ func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
 
 	return dec.BytesRead(), nil
 }
+
+// WriteTo writes binary encoding of a MultiPointProof
+func (proof *MultiPointProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bn254.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.H,
+		&proof.HFolded,
+		proof.ClaimedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes MultiPointProof data from reader.
+func (proof *MultiPointProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bn254.NewDecoder(r)
+	toDecode := []interface{}{
+		&proof.H,
+		&proof.HFolded,
+		&proof.ClaimedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
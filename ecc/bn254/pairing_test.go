@@ -52,6 +52,33 @@ func TestPairing(t *testing.T) {
 		genA,
 	))
 
+	properties.Property("[BN254] IsInSubGroup should accept pairing outputs and reject cyclotomic elements outside GT", prop.ForAll(
+		func(a GT, e fr.Element) bool {
+			var ag1 G1Affine
+			var ebigint big.Int
+			e.BigInt(&ebigint)
+			ag1.ScalarMultiplication(&g1GenAff, &ebigint)
+			res, err := Pair([]G1Affine{ag1}, []G2Affine{g2GenAff})
+			if err != nil {
+				return false
+			}
+
+			// a^(easy part of the final exponentiation) is in the cyclotomic
+			// subgroup but not of order r
+			var b GT
+			b.Conjugate(&a)
+			a.Inverse(&a)
+			b.Mul(&b, &a)
+
+			a.FrobeniusSquare(&b).
+				Mul(&a, &b)
+
+			return res.IsInSubGroup() && !a.IsInSubGroup()
+		},
+		genA,
+		genR1,
+	))
+
 	properties.Property("[BN254] Exp, CyclotomicExp and ExpGLV results must be the same in GT (small and big exponents)", prop.ForAll(
 		func(a GT, e fr.Element) bool {
 
@@ -152,6 +179,70 @@ func TestPairing(t *testing.T) {
 		genR2,
 	))
 
+	properties.Property("[BN254] PairingCheck should accept e(aG1, bG2)·e(-abG1, G2) and reject a perturbed input", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ab fr.Element
+			ab.Mul(&a, &b)
+			var abigint, bbigint, abbigint big.Int
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+			ab.BigInt(&abbigint)
+
+			var ag1, abg1 G1Affine
+			var bg2 G2Affine
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			abg1.ScalarMultiplication(&g1GenAff, &abbigint)
+			abg1.Neg(&abg1)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			tabP := []G1Affine{ag1, abg1}
+			tabQ := []G2Affine{bg2, g2GenAff}
+			valid, err := PairingCheck(tabP, tabQ)
+			if err != nil || !valid {
+				return false
+			}
+
+			// perturb one input
+			tabP[1].Add(&tabP[1], &g1GenAff)
+			valid, err = PairingCheck(tabP, tabQ)
+			return err == nil && !valid
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BN254] e(aG1, bG2)·e(aG1, -bG2) should be 1, with PairingCheck and PairingCheckFixedQ", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var abigint, bbigint big.Int
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+			negBg2 := PrecomputeNegated(bg2)
+
+			tabP := []G1Affine{ag1, ag1}
+			valid, err := PairingCheck(tabP, []G2Affine{bg2, negBg2})
+			if err != nil || !valid {
+				return false
+			}
+
+			valid, err = PairingCheckFixedQ(
+				tabP,
+				[][2][len(LoopCounter)]LineEvaluationAff{
+					PrecomputeLines(bg2),
+					PrecomputeLines(negBg2),
+				})
+			return err == nil && valid
+		},
+		genR1,
+		genR2,
+	))
+
 	properties.Property("[BN254] Pair should output the same result with MillerLoop or MillerLoopFixedQ", prop.ForAll(
 		func(a, b fr.Element) bool {
 
@@ -306,6 +397,54 @@ func TestMillerLoop(t *testing.T) {
 		genR2,
 	))
 
+	properties.Property("[BN254] FinalExponentiation of MillerLoop should be equal to Pair", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			// single pair
+			ml1, err := MillerLoop([]G1Affine{ag1}, []G2Affine{bg2})
+			if err != nil {
+				return false
+			}
+			pair1, _ := Pair([]G1Affine{ag1}, []G2Affine{bg2})
+			res := FinalExponentiation(&ml1)
+			if !res.Equal(&pair1) {
+				return false
+			}
+
+			// multiple pairs
+			P := []G1Affine{ag1, g1GenAff}
+			Q := []G2Affine{g2GenAff, bg2}
+			ml2, err := MillerLoop(P, Q)
+			if err != nil {
+				return false
+			}
+			pair2, _ := Pair(P, Q)
+			res = FinalExponentiation(&ml2)
+			if !res.Equal(&pair2) {
+				return false
+			}
+
+			// Miller loops accumulated with a single final exponentiation
+			var expected GT
+			expected.Mul(&pair1, &pair2)
+			res = FinalExponentiation(&ml1, &ml2)
+			return res.Equal(&expected)
+		},
+		genR1,
+		genR2,
+	))
+
 	properties.Property("[BN254] MillerLoop and MillerLoopFixedQ should skip pairs with a point at infinity", prop.ForAll(
 		func(a, b fr.Element) bool {
 
@@ -409,7 +548,10 @@ func TestMillerLoop(t *testing.T) {
 
 			res, _ := Pair([]G1Affine{ag1}, []G2Affine{bg2})
 
-			compressed, _ := res.CompressTorus()
+			compressed, err := res.CompressTorus()
+			if err != nil {
+				return false
+			}
 			decompressed := compressed.DecompressTorus()
 
 			return decompressed.Equal(&res)
@@ -422,6 +564,61 @@ func TestMillerLoop(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestMillerLoopChecked(t *testing.T) {
+	t.Parallel()
+
+	// a point on the twist which is not in G2
+	var q G2Affine
+	for {
+		q.X.MustSetRandom()
+		rhs := q.X
+		rhs.Square(&q.X).Mul(&rhs, &q.X).Add(&rhs, &bTwistCurveCoeff)
+		if rhs.Legendre() != 1 {
+			continue
+		}
+		q.Y.Sqrt(&rhs)
+		if !q.IsInSubGroup() {
+			break
+		}
+	}
+	if _, err := MillerLoopChecked([]G1Affine{g1GenAff}, []G2Affine{q}); err == nil {
+		t.Fatal("MillerLoopChecked should reject a point outside of G2")
+	}
+
+	// a point on the curve which is not in G1, if the cofactor of G1 isn't 1
+	var p G1Affine
+	for x := uint64(1); x < 256; x++ {
+		var rhs fp.Element
+		p.X.SetUint64(x)
+		rhs.Square(&p.X).Mul(&rhs, &p.X).Add(&rhs, &bCurveCoeff)
+		if p.Y.Sqrt(&rhs) != nil && !p.IsInSubGroup() {
+			if _, err := MillerLoopChecked([]G1Affine{p}, []G2Affine{g2GenAff}); err == nil {
+				t.Fatal("MillerLoopChecked should reject a point outside of G1")
+			}
+			break
+		}
+	}
+
+	// valid inputs, including the point at infinity
+	var infinity G1Affine
+	P := []G1Affine{g1GenAff, infinity}
+	Q := []G2Affine{g2GenAff, g2GenAff}
+	expected, err := MillerLoop(P, Q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := MillerLoopChecked(P, Q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Equal(&expected) {
+		t.Fatal("MillerLoopChecked and MillerLoop should output the same result")
+	}
+	if _, err := MillerLoopChecked(P, Q[:1]); err == nil {
+		t.Fatal("MillerLoopChecked should reject inputs of different sizes")
+	}
+}
+
 // ------------------------------------------------------------
 // benches
 
@@ -439,6 +636,26 @@ func BenchmarkPairing(b *testing.B) {
 	}
 }
 
+// BenchmarkPairingWithSubgroupChecks measures Pair preceded by the subgroup
+// checks a caller must perform on untrusted inputs, since Pair itself does not
+// check subgroup membership. Compare with BenchmarkPairing.
+func BenchmarkPairingWithSubgroupChecks(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !g1GenAff.IsInSubGroup() || !g2GenAff.IsInSubGroup() {
+			b.Fatal("generators should be in the subgroup")
+		}
+		Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	}
+}
+
 func BenchmarkMillerLoop(b *testing.B) {
 
 	var g1GenAff G1Affine
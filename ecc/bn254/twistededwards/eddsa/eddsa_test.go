@@ -6,7 +6,9 @@
 package eddsa
 
 import (
+	"bytes"
 	"crypto/sha256"
+	stdhash "hash"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -254,8 +256,175 @@ func TestEddsaSHA256(t *testing.T) {
 
 }
 
+func TestSignWithTable(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	hFunc := sha256.New()
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PublicKey
+
+	table := twistededwards.NewFixedBaseTable(twistededwards.GetEdwardsCurve().Base, 4)
+	for _, msg := range []string{"message", "another message", ""} {
+		expected, err := privKey.Sign([]byte(msg), hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature, err := privKey.SignWithTable([]byte(msg), hFunc, table)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, signature) {
+			t.Fatal("SignWithTable and Sign should produce the same signature")
+		}
+		res, err := pubKey.Verify(signature, []byte(msg), hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res {
+			t.Fatal("Verify correct signature should return true")
+		}
+	}
+
+	// table for another base point
+	otherBase := table.Base()
+	otherBase.Double(&otherBase)
+	if _, err = privKey.SignWithTable([]byte("message"), hFunc, twistededwards.NewFixedBaseTable(otherBase, 2)); err == nil {
+		t.Fatal("SignWithTable should reject a table for another base point")
+	}
+}
+
+func TestSignWithContext(t *testing.T) {
+	testSignWithContext(t, sha256.New(), []byte("message"), []byte("wrong_message"))
+}
+
+func TestSignWithContextMIMC(t *testing.T) {
+	// MiMC only hashes sequences of canonical field elements
+	var m, wrong fr.Element
+	m.SetString("123456789")
+	wrong.SetString("987654321")
+	msg := m.Bytes()
+	wrongMsg := wrong.Bytes()
+	testSignWithContext(t, mimc.NewMiMC(), msg[:], wrongMsg[:])
+}
+
+func testSignWithContext(t *testing.T, hFunc stdhash.Hash, msg, wrongMsg []byte) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PublicKey
+
+	contexts := [][]byte{nil, []byte("protocol A"), []byte("protocol B"), make([]byte, 255)}
+	signatures := make([][]byte, len(contexts))
+	for i := range contexts {
+		signatures[i], err = privKey.SignWithContext(msg, contexts[i], hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range signatures {
+		for j := range contexts {
+			res, err := pubKey.VerifyWithContext(signatures[i], msg, contexts[j], hFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != (i == j) {
+				t.Fatalf("signature with context %d verified with context %d: %v", i, j, res)
+			}
+		}
+
+		// signatures with context don't verify without context
+		res, err := pubKey.Verify(signatures[i], msg, hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res {
+			t.Fatal("signature with context should not verify without context")
+		}
+
+		// nor for another message
+		res, err = pubKey.VerifyWithContext(signatures[i], wrongMsg, contexts[i], hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res {
+			t.Fatal("Verify wrong signature should be false")
+		}
+	}
+
+	// and the nonces differ between contexts
+	for i := range signatures {
+		for j := i + 1; j < len(signatures); j++ {
+			if bytes.Equal(signatures[i][:sizeFr], signatures[j][:sizeFr]) {
+				t.Fatal("signatures with different contexts should have different nonces")
+			}
+		}
+	}
+
+	// signatures without context don't verify with the empty context
+	signature, err := privKey.Sign(msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := pubKey.VerifyWithContext(signature, msg, nil, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res {
+		t.Fatal("signature without context should not verify with context")
+	}
+
+	// context too long
+	if _, err = privKey.SignWithContext(msg, make([]byte, 256), hFunc); err != ErrContextTooLong {
+		t.Fatal("SignWithContext should reject contexts longer than 255 bytes")
+	}
+	if _, err = pubKey.VerifyWithContext(signature, msg, make([]byte, 256), hFunc); err != ErrContextTooLong {
+		t.Fatal("VerifyWithContext should reject contexts longer than 255 bytes")
+	}
+}
+
 // benchmarks
 
+func BenchmarkSign(b *testing.B) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	hFunc := hash.MIMC_BN254.New()
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var frMsg fr.Element
+	frMsg.SetString("44717650746155748460101257525078853138837311576962212923649547644148297035978")
+	msgBin := frMsg.Bytes()
+
+	b.Run("generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = privKey.Sign(msgBin[:], hFunc)
+		}
+	})
+
+	table := twistededwards.NewFixedBaseTable(twistededwards.GetEdwardsCurve().Base, 8)
+	b.Run("table", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = privKey.SignWithTable(msgBin[:], hFunc, table)
+		}
+	})
+}
+
 func BenchmarkVerify(b *testing.B) {
 
 	src := rand.NewSource(0)
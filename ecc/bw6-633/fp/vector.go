@@ -346,6 +346,47 @@ func (vector Vector) Equal(other Vector) bool {
 	return slices.Equal(vector, other)
 }
 
+// InnerProduct returns ∑ a[i]⋅b[i], or an error if a and b don't have the same length.
+//
+// It is Vector.InnerProduct with a length check instead of a panic: the assembly
+// implementation is used when available, otherwise the products are accumulated
+// without reduction and reduced once at the end.
+func InnerProduct(a, b []Element) (Element, error) {
+	if len(a) != len(b) {
+		return Element{}, errors.New("InnerProduct: vectors don't have the same length")
+	}
+	v := Vector(a)
+	return v.InnerProduct(b), nil
+}
+
+// BatchFromMont converts the elements from Montgomery form to regular form, in place,
+// e.g. to hand them to a library that expects the regular limbs representation.
+// Large slices are processed in parallel.
+func BatchFromMont(elements []Element) {
+	execute(len(elements), func(start, end int) {
+		for i := start; i < end; i++ {
+			elements[i].fromMont()
+		}
+	}, batchNbTasks(len(elements)))
+}
+
+// BatchToMont converts the elements from regular form to Montgomery form, in place.
+// It is the inverse of BatchFromMont. Large slices are processed in parallel.
+func BatchToMont(elements []Element) {
+	execute(len(elements), func(start, end int) {
+		for i := start; i < end; i++ {
+			elements[i].toMont()
+		}
+	}, batchNbTasks(len(elements)))
+}
+
+// batchNbTasks returns the number of go routines used to process n elements;
+// small slices are processed by the caller.
+func batchNbTasks(n int) int {
+	const minPerTask = 1 << 12
+	return max(1, min(runtime.NumCPU(), n/minPerTask))
+}
+
 func addVecGeneric(res, a, b Vector) {
 	if len(a) != len(b) || len(a) != len(res) {
 		panic("vector.Add: vectors don't have the same length")
@@ -383,11 +424,440 @@ func innerProductVecGeneric(res *Element, a, b Vector) {
 	if len(a) != len(b) {
 		panic("vector.InnerProduct: vectors don't have the same length")
 	}
-	var tmp Element
-	for i := 0; i < len(a); i++ {
-		tmp.Mul(&a[i], &b[i])
-		res.Add(res, &tmp)
+
+	// the products are accumulated column-wise without reduction, and a single
+	// reduction is performed at the end, instead of one per product.
+	// col[k] = ∑ a[·][i]⋅b[·][j] for i+j = k, on 3 words, where a and b are
+	// in Montgomery form
+	var col [19][3]uint64
+	var hi, lo, carry uint64
+	for k := range a {
+		x, y := &a[k], &b[k]
+		hi, lo = bits.Mul64(x[0], y[0])
+		col[0][0], carry = bits.Add64(col[0][0], lo, 0)
+		col[0][1], carry = bits.Add64(col[0][1], hi, carry)
+		col[0][2] += carry
+		hi, lo = bits.Mul64(x[0], y[1])
+		col[1][0], carry = bits.Add64(col[1][0], lo, 0)
+		col[1][1], carry = bits.Add64(col[1][1], hi, carry)
+		col[1][2] += carry
+		hi, lo = bits.Mul64(x[0], y[2])
+		col[2][0], carry = bits.Add64(col[2][0], lo, 0)
+		col[2][1], carry = bits.Add64(col[2][1], hi, carry)
+		col[2][2] += carry
+		hi, lo = bits.Mul64(x[0], y[3])
+		col[3][0], carry = bits.Add64(col[3][0], lo, 0)
+		col[3][1], carry = bits.Add64(col[3][1], hi, carry)
+		col[3][2] += carry
+		hi, lo = bits.Mul64(x[0], y[4])
+		col[4][0], carry = bits.Add64(col[4][0], lo, 0)
+		col[4][1], carry = bits.Add64(col[4][1], hi, carry)
+		col[4][2] += carry
+		hi, lo = bits.Mul64(x[0], y[5])
+		col[5][0], carry = bits.Add64(col[5][0], lo, 0)
+		col[5][1], carry = bits.Add64(col[5][1], hi, carry)
+		col[5][2] += carry
+		hi, lo = bits.Mul64(x[0], y[6])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[0], y[7])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[0], y[8])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[0], y[9])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[1], y[0])
+		col[1][0], carry = bits.Add64(col[1][0], lo, 0)
+		col[1][1], carry = bits.Add64(col[1][1], hi, carry)
+		col[1][2] += carry
+		hi, lo = bits.Mul64(x[1], y[1])
+		col[2][0], carry = bits.Add64(col[2][0], lo, 0)
+		col[2][1], carry = bits.Add64(col[2][1], hi, carry)
+		col[2][2] += carry
+		hi, lo = bits.Mul64(x[1], y[2])
+		col[3][0], carry = bits.Add64(col[3][0], lo, 0)
+		col[3][1], carry = bits.Add64(col[3][1], hi, carry)
+		col[3][2] += carry
+		hi, lo = bits.Mul64(x[1], y[3])
+		col[4][0], carry = bits.Add64(col[4][0], lo, 0)
+		col[4][1], carry = bits.Add64(col[4][1], hi, carry)
+		col[4][2] += carry
+		hi, lo = bits.Mul64(x[1], y[4])
+		col[5][0], carry = bits.Add64(col[5][0], lo, 0)
+		col[5][1], carry = bits.Add64(col[5][1], hi, carry)
+		col[5][2] += carry
+		hi, lo = bits.Mul64(x[1], y[5])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[1], y[6])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[1], y[7])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[1], y[8])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[1], y[9])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[2], y[0])
+		col[2][0], carry = bits.Add64(col[2][0], lo, 0)
+		col[2][1], carry = bits.Add64(col[2][1], hi, carry)
+		col[2][2] += carry
+		hi, lo = bits.Mul64(x[2], y[1])
+		col[3][0], carry = bits.Add64(col[3][0], lo, 0)
+		col[3][1], carry = bits.Add64(col[3][1], hi, carry)
+		col[3][2] += carry
+		hi, lo = bits.Mul64(x[2], y[2])
+		col[4][0], carry = bits.Add64(col[4][0], lo, 0)
+		col[4][1], carry = bits.Add64(col[4][1], hi, carry)
+		col[4][2] += carry
+		hi, lo = bits.Mul64(x[2], y[3])
+		col[5][0], carry = bits.Add64(col[5][0], lo, 0)
+		col[5][1], carry = bits.Add64(col[5][1], hi, carry)
+		col[5][2] += carry
+		hi, lo = bits.Mul64(x[2], y[4])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[2], y[5])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[2], y[6])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[2], y[7])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[2], y[8])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[2], y[9])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[3], y[0])
+		col[3][0], carry = bits.Add64(col[3][0], lo, 0)
+		col[3][1], carry = bits.Add64(col[3][1], hi, carry)
+		col[3][2] += carry
+		hi, lo = bits.Mul64(x[3], y[1])
+		col[4][0], carry = bits.Add64(col[4][0], lo, 0)
+		col[4][1], carry = bits.Add64(col[4][1], hi, carry)
+		col[4][2] += carry
+		hi, lo = bits.Mul64(x[3], y[2])
+		col[5][0], carry = bits.Add64(col[5][0], lo, 0)
+		col[5][1], carry = bits.Add64(col[5][1], hi, carry)
+		col[5][2] += carry
+		hi, lo = bits.Mul64(x[3], y[3])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[3], y[4])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[3], y[5])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[3], y[6])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[3], y[7])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[3], y[8])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[3], y[9])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[4], y[0])
+		col[4][0], carry = bits.Add64(col[4][0], lo, 0)
+		col[4][1], carry = bits.Add64(col[4][1], hi, carry)
+		col[4][2] += carry
+		hi, lo = bits.Mul64(x[4], y[1])
+		col[5][0], carry = bits.Add64(col[5][0], lo, 0)
+		col[5][1], carry = bits.Add64(col[5][1], hi, carry)
+		col[5][2] += carry
+		hi, lo = bits.Mul64(x[4], y[2])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[4], y[3])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[4], y[4])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[4], y[5])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[4], y[6])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[4], y[7])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[4], y[8])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[4], y[9])
+		col[13][0], carry = bits.Add64(col[13][0], lo, 0)
+		col[13][1], carry = bits.Add64(col[13][1], hi, carry)
+		col[13][2] += carry
+		hi, lo = bits.Mul64(x[5], y[0])
+		col[5][0], carry = bits.Add64(col[5][0], lo, 0)
+		col[5][1], carry = bits.Add64(col[5][1], hi, carry)
+		col[5][2] += carry
+		hi, lo = bits.Mul64(x[5], y[1])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[5], y[2])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[5], y[3])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[5], y[4])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[5], y[5])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[5], y[6])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[5], y[7])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[5], y[8])
+		col[13][0], carry = bits.Add64(col[13][0], lo, 0)
+		col[13][1], carry = bits.Add64(col[13][1], hi, carry)
+		col[13][2] += carry
+		hi, lo = bits.Mul64(x[5], y[9])
+		col[14][0], carry = bits.Add64(col[14][0], lo, 0)
+		col[14][1], carry = bits.Add64(col[14][1], hi, carry)
+		col[14][2] += carry
+		hi, lo = bits.Mul64(x[6], y[0])
+		col[6][0], carry = bits.Add64(col[6][0], lo, 0)
+		col[6][1], carry = bits.Add64(col[6][1], hi, carry)
+		col[6][2] += carry
+		hi, lo = bits.Mul64(x[6], y[1])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[6], y[2])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[6], y[3])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[6], y[4])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[6], y[5])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[6], y[6])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[6], y[7])
+		col[13][0], carry = bits.Add64(col[13][0], lo, 0)
+		col[13][1], carry = bits.Add64(col[13][1], hi, carry)
+		col[13][2] += carry
+		hi, lo = bits.Mul64(x[6], y[8])
+		col[14][0], carry = bits.Add64(col[14][0], lo, 0)
+		col[14][1], carry = bits.Add64(col[14][1], hi, carry)
+		col[14][2] += carry
+		hi, lo = bits.Mul64(x[6], y[9])
+		col[15][0], carry = bits.Add64(col[15][0], lo, 0)
+		col[15][1], carry = bits.Add64(col[15][1], hi, carry)
+		col[15][2] += carry
+		hi, lo = bits.Mul64(x[7], y[0])
+		col[7][0], carry = bits.Add64(col[7][0], lo, 0)
+		col[7][1], carry = bits.Add64(col[7][1], hi, carry)
+		col[7][2] += carry
+		hi, lo = bits.Mul64(x[7], y[1])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[7], y[2])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[7], y[3])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[7], y[4])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[7], y[5])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[7], y[6])
+		col[13][0], carry = bits.Add64(col[13][0], lo, 0)
+		col[13][1], carry = bits.Add64(col[13][1], hi, carry)
+		col[13][2] += carry
+		hi, lo = bits.Mul64(x[7], y[7])
+		col[14][0], carry = bits.Add64(col[14][0], lo, 0)
+		col[14][1], carry = bits.Add64(col[14][1], hi, carry)
+		col[14][2] += carry
+		hi, lo = bits.Mul64(x[7], y[8])
+		col[15][0], carry = bits.Add64(col[15][0], lo, 0)
+		col[15][1], carry = bits.Add64(col[15][1], hi, carry)
+		col[15][2] += carry
+		hi, lo = bits.Mul64(x[7], y[9])
+		col[16][0], carry = bits.Add64(col[16][0], lo, 0)
+		col[16][1], carry = bits.Add64(col[16][1], hi, carry)
+		col[16][2] += carry
+		hi, lo = bits.Mul64(x[8], y[0])
+		col[8][0], carry = bits.Add64(col[8][0], lo, 0)
+		col[8][1], carry = bits.Add64(col[8][1], hi, carry)
+		col[8][2] += carry
+		hi, lo = bits.Mul64(x[8], y[1])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[8], y[2])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[8], y[3])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[8], y[4])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[8], y[5])
+		col[13][0], carry = bits.Add64(col[13][0], lo, 0)
+		col[13][1], carry = bits.Add64(col[13][1], hi, carry)
+		col[13][2] += carry
+		hi, lo = bits.Mul64(x[8], y[6])
+		col[14][0], carry = bits.Add64(col[14][0], lo, 0)
+		col[14][1], carry = bits.Add64(col[14][1], hi, carry)
+		col[14][2] += carry
+		hi, lo = bits.Mul64(x[8], y[7])
+		col[15][0], carry = bits.Add64(col[15][0], lo, 0)
+		col[15][1], carry = bits.Add64(col[15][1], hi, carry)
+		col[15][2] += carry
+		hi, lo = bits.Mul64(x[8], y[8])
+		col[16][0], carry = bits.Add64(col[16][0], lo, 0)
+		col[16][1], carry = bits.Add64(col[16][1], hi, carry)
+		col[16][2] += carry
+		hi, lo = bits.Mul64(x[8], y[9])
+		col[17][0], carry = bits.Add64(col[17][0], lo, 0)
+		col[17][1], carry = bits.Add64(col[17][1], hi, carry)
+		col[17][2] += carry
+		hi, lo = bits.Mul64(x[9], y[0])
+		col[9][0], carry = bits.Add64(col[9][0], lo, 0)
+		col[9][1], carry = bits.Add64(col[9][1], hi, carry)
+		col[9][2] += carry
+		hi, lo = bits.Mul64(x[9], y[1])
+		col[10][0], carry = bits.Add64(col[10][0], lo, 0)
+		col[10][1], carry = bits.Add64(col[10][1], hi, carry)
+		col[10][2] += carry
+		hi, lo = bits.Mul64(x[9], y[2])
+		col[11][0], carry = bits.Add64(col[11][0], lo, 0)
+		col[11][1], carry = bits.Add64(col[11][1], hi, carry)
+		col[11][2] += carry
+		hi, lo = bits.Mul64(x[9], y[3])
+		col[12][0], carry = bits.Add64(col[12][0], lo, 0)
+		col[12][1], carry = bits.Add64(col[12][1], hi, carry)
+		col[12][2] += carry
+		hi, lo = bits.Mul64(x[9], y[4])
+		col[13][0], carry = bits.Add64(col[13][0], lo, 0)
+		col[13][1], carry = bits.Add64(col[13][1], hi, carry)
+		col[13][2] += carry
+		hi, lo = bits.Mul64(x[9], y[5])
+		col[14][0], carry = bits.Add64(col[14][0], lo, 0)
+		col[14][1], carry = bits.Add64(col[14][1], hi, carry)
+		col[14][2] += carry
+		hi, lo = bits.Mul64(x[9], y[6])
+		col[15][0], carry = bits.Add64(col[15][0], lo, 0)
+		col[15][1], carry = bits.Add64(col[15][1], hi, carry)
+		col[15][2] += carry
+		hi, lo = bits.Mul64(x[9], y[7])
+		col[16][0], carry = bits.Add64(col[16][0], lo, 0)
+		col[16][1], carry = bits.Add64(col[16][1], hi, carry)
+		col[16][2] += carry
+		hi, lo = bits.Mul64(x[9], y[8])
+		col[17][0], carry = bits.Add64(col[17][0], lo, 0)
+		col[17][1], carry = bits.Add64(col[17][1], hi, carry)
+		col[17][2] += carry
+		hi, lo = bits.Mul64(x[9], y[9])
+		col[18][0], carry = bits.Add64(col[18][0], lo, 0)
+		col[18][1], carry = bits.Add64(col[18][1], hi, carry)
+		col[18][2] += carry
+	}
+
+	// t = ∑ col[k]⋅2⁶⁴ᵏ
+	var t [20 + 1]uint64
+	for k := range col {
+		t[k], carry = bits.Add64(t[k], col[k][0], 0)
+		t[k+1], carry = bits.Add64(t[k+1], col[k][1], carry)
+		t[k+2], carry = bits.Add64(t[k+2], col[k][2], carry)
+		for l := k + 3; carry != 0 && l < len(t); l++ {
+			t[l], carry = bits.Add64(t[l], 0, carry)
+		}
 	}
+
+	// with t = lo + hi⋅R + top⋅R², the result in Montgomery form is
+	// t⋅R⁻¹ = lo⋅R⁻¹ + hi + top⋅R (mod q)
+	var zLo, zHi, zTop Element
+	copy(zLo[:], t[:10])
+	copy(zHi[:], t[10:20])
+	// zLo, zHi < R, and since q < R/2 the Montgomery reduction doesn't overflow
+	zLo.fromMont()
+	zHi.fromMont().toMont()
+	zTop.SetUint64(t[20])
+
+	zLo.Add(&zLo, &zHi).Add(&zLo, &zTop)
+	res.Add(res, &zLo)
 }
 
 func mulVecGeneric(res, a, b Vector) {
@@ -200,6 +200,101 @@ func TestPolynomialAdd(t *testing.T) {
 	}
 }
 
+// mul returns the product of a and b, computed naively.
+func mul(a, b Polynomial) Polynomial {
+	res := make(Polynomial, len(a)+len(b)-1)
+	var t fr.Element
+	for i := range a {
+		for j := range b {
+			t.Mul(&a[i], &b[j])
+			res[i+j].Add(&res[i+j], &t)
+		}
+	}
+	return res
+}
+
+func TestPolynomialDivRem(t *testing.T) {
+
+	random := func(n int) Polynomial {
+		p := make(Polynomial, n)
+		for i := range p {
+			p[i].MustSetRandom()
+		}
+		return p
+	}
+
+	for _, sizes := range [][2]int{{20, 5}, {20, 1}, {5, 5}, {3, 7}, {1, 1}} {
+		dividend, divisor := random(sizes[0]), random(sizes[1])
+		dividendBackup := dividend.Clone()
+
+		q, r, err := dividend.DivRem(divisor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !dividend.Equal(dividendBackup) {
+			t.Fatal("side effect, dividend should not have been modified")
+		}
+		if len(r) != max(sizes[1]-1, 1) {
+			t.Fatal("remainder has the wrong size")
+		}
+
+		// dividend = q * divisor + r
+		var res Polynomial
+		res.Add(mul(q, divisor), r)
+		for i := range res {
+			if i < len(dividend) {
+				if !res[i].Equal(&dividend[i]) {
+					t.Fatal("q * divisor + r != dividend")
+				}
+			} else if !res[i].IsZero() {
+				t.Fatal("q * divisor + r != dividend")
+			}
+		}
+	}
+
+	// trailing zeroes in the divisor are ignored
+	dividend, divisor := random(10), random(3)
+	q, r, err := dividend.DivRem(divisor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_q, _r, err := dividend.DivRem(append(divisor, fr.Element{}, fr.Element{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(_q) || !r.Equal(_r) {
+		t.Fatal("trailing zeroes in the divisor should be ignored")
+	}
+
+	// dividing by X - a where a is a root leaves no remainder
+	var a fr.Element
+	a.MustSetRandom()
+	var negA fr.Element
+	negA.Neg(&a)
+	xMinusA := Polynomial{negA, fr.Element{}}
+	xMinusA[1].SetOne()
+	g := random(10)
+	f := mul(g, xMinusA)
+	q, r, err = f.DivRem(xMinusA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 1 || !r[0].IsZero() {
+		t.Fatal("remainder should be zero")
+	}
+	if !q.Equal(g) {
+		t.Fatal("quotient should be g")
+	}
+
+	// division by zero
+	if _, _, err := f.DivRem(make(Polynomial, 3)); err != ErrZeroDivisor {
+		t.Fatal("expected ErrZeroDivisor")
+	}
+	if _, _, err := f.DivRem(nil); err != ErrZeroDivisor {
+		t.Fatal("expected ErrZeroDivisor")
+	}
+}
+
 func TestPolynomialText(t *testing.T) {
 	var one, negTwo fr.Element
 	one.SetOne()
@@ -6,6 +6,8 @@
 package bw6633
 
 import (
+	"io"
+
 	"github.com/consensys/gnark-crypto/ecc/bw6-633/fp"
 	"github.com/consensys/gnark-crypto/ecc/bw6-633/hash_to_curve"
 )
@@ -19,6 +21,17 @@ func MapToG2(u fp.Element) G2Affine {
 	return res
 }
 
+// MapToCurveG2 is the map_to_curve step of RFC 9380: it maps u to a point on the curve using the
+// SSWU map followed by the isogeny to the target curve.
+// The result is not guaranteed to be in G2; use [MapToG2] to also clear the cofactor.
+//
+// See https://www.rfc-editor.org/rfc/rfc9380.html#section-6
+func MapToCurveG2(u *fp.Element) G2Affine {
+	res := MapToCurve2(u)
+	hash_to_curve.G2Isogeny(&res.X, &res.Y)
+	return res
+}
+
 // EncodeToG2 hashes a message to a point on the G2 curve using the SSWU map.
 // It is faster than [HashToG2], but the result is not uniformly distributed. Unsuitable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
@@ -50,7 +63,24 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	if err != nil {
 		return G2Affine{}, err
 	}
+	return hashToG2(u), nil
+}
+
+// HashToG2Reader is [HashToG2] for a message read from r until io.EOF.
+//
+// The message is streamed through expand_message_xmd and never held in memory, so that it can be
+// arbitrarily large. As in [HashToG2], domain separation tags longer than 255 bytes are
+// first hashed down as specified in RFC 9380 section 5.3.3.
+func HashToG2Reader(r io.Reader, dst []byte) (G2Affine, error) {
+	u, err := fp.HashReader(r, dst, 2*1)
+	if err != nil {
+		return G2Affine{}, err
+	}
+	return hashToG2(u), nil
+}
 
+// hashToG2 maps the 2 field elements u obtained by hash_to_field to a point in G2.
+func hashToG2(u []fp.Element) G2Affine {
 	Q0 := MapToCurve2(&u[0])
 	Q1 := MapToCurve2(&u[1])
 
@@ -65,7 +95,7 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	_Q1.ClearCofactor(&_Q1)
 
 	Q1.FromJacobian(&_Q1)
-	return Q1, nil
+	return Q1
 }
 
 // MapToCurve2 implements the SSWU map. It does not perform cofactor clearing nor isogeny. For map to group, use [MapToG2].
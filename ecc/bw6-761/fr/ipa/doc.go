@@ -0,0 +1,20 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ipa implements a polynomial commitment scheme based on the
+// inner product argument of Bulletproofs (https://eprint.iacr.org/2017/1066.pdf),
+// as used in Halo (https://eprint.iacr.org/2019/1021.pdf, section 3).
+//
+// A polynomial p of degree < n is committed as the Pedersen vector commitment
+// C = ∑ᵢ[pᵢ]Gᵢ, where the generators Gᵢ and U are derived with hash-to-curve, so
+// that their discrete logarithms are unknown. The setup is transparent: there is
+// no trapdoor to discard.
+//
+// An opening at z proves that p(z) = ⟨p, (1, z, z², ..., zⁿ⁻¹)⟩ by halving the
+// vectors log₂(n) times. The proof holds 2·log₂(n) points of G1 and 2 scalars,
+// and is verified in time linear in n, with a single multi exponentiation.
+//
+// The commitments are binding but not hiding.
+package ipa
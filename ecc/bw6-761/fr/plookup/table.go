@@ -50,6 +50,10 @@ type ProofLookupTables struct {
 // For instance, if t is the truth table of the XOR function, t will be populated such
 // that t[:][i] contains the i-th entry of the truth table, so t[0][i] XOR t[1][i] = t[2][i].
 //
+// The columns of f and t are folded into a single column with a random linear
+// combination, using a challenge derived from the commitments of all the columns,
+// before running the single column argument (see ProveLookupVector).
+//
 // The fr.Vector in f and t are supposed to be of the same size constant size.
 func ProveLookupTables(pk kzg.ProvingKey, f, t []fr.Vector) (ProofLookupTables, error) {
 
@@ -212,6 +216,10 @@ func VerifyLookupTables(vk kzg.VerifyingKey, proof ProofLookupTables) error {
 	}
 
 	// check that the folded commitment of the ts is a permutation of proof.FoldedProof.t
+	t1, t2 := proof.permutationProof.Commitments()
+	if !comt.Equal(&t1) || !proof.foldedProof.t.Equal(&t2) {
+		return ErrFoldedCommitment
+	}
 	err = permutation.Verify(vk, proof.permutationProof)
 	if err != nil {
 		return err
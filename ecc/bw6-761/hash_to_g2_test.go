@@ -8,8 +8,11 @@ package bw6761
 import (
 	"github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
 
+	"bytes"
 	"math/rand"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/consensys/gnark-crypto/ecc/bw6-761/hash_to_curve"
 	"github.com/leanovate/gopter"
@@ -75,6 +78,8 @@ func TestMapToCurve2(t *testing.T) {
 		q := MapToCurve2(&u)
 		hash_to_curve.G2Isogeny(&q.X, &q.Y)
 		g2TestMatchPoint(t, "Q", c.msg, c.Q, &q)
+		q = MapToCurveG2(&u)
+		g2TestMatchPoint(t, "Q", c.msg, c.Q, &q)
 	}
 
 	for _, c := range hashToG2Vector.cases {
@@ -110,6 +115,24 @@ func TestMapToG2(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[G2] MapToCurveG2 should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG2(&a)
+			return g.IsOnCurve()
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G2] MapToG2 should be MapToCurveG2 followed by cofactor clearing", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG2(&a)
+			g.ClearCofactor(&g)
+			h := MapToG2(a)
+			return g.Equal(&h)
+		},
+		GenFp(),
+	))
+
 	properties.Property("[G2] mapping to curve should be deterministic", prop.ForAll(
 		func(a fp.Element) bool {
 			g1 := MapToG2(a)
@@ -144,6 +167,33 @@ func TestHashToG2(t *testing.T) {
 	}
 }
 
+func TestHashToG2Reader(t *testing.T) {
+	t.Parallel()
+	for _, c := range hashToG2Vector.cases {
+		p, err := HashToG2Reader(strings.NewReader(c.msg), hashToG2Vector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g2TestMatchPoint(t, "P", c.msg, c.P, &p)
+	}
+
+	// large message read in small chunks, with a DST longer than 255 bytes
+	msg := bytes.Repeat([]byte("gnark-crypto"), 1<<17)
+	for _, dst := range [][]byte{hashToG2Vector.dst, bytes.Repeat([]byte("long-DST-"), 50)} {
+		expected, err := HashToG2(msg, dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := HashToG2Reader(iotest.HalfReader(bytes.NewReader(msg)), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&expected) {
+			t.Fatalf("streamed hash (dst length %d) differs from HashToG2", len(dst))
+		}
+	}
+}
+
 func BenchmarkEncodeToG2(b *testing.B) {
 	const size = 54
 	bytes := make([]byte, size)
@@ -7,7 +7,11 @@ package kzg
 
 import (
 	"bytes"
+	crand "crypto/rand"
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
 	"math/big"
 	"slices"
 	"sync"
@@ -80,6 +84,96 @@ func TestMpcSetup(t *testing.T) {
 	}
 }
 
+func TestContribute(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 32
+	srs, err := NewSRS(size, big.NewInt(42))
+	assert.NoError(err)
+
+	clone := func(srs *SRS) *SRS {
+		res := *srs
+		res.Pk.G1 = slices.Clone(srs.Pk.G1)
+		return &res
+	}
+
+	// two successive contributions
+	prev := clone(srs)
+	for range 2 {
+		next := clone(prev)
+		proof, err := next.Contribute(crand.Reader)
+		assert.NoError(err)
+		assert.False(next.Vk.G2[1].Equal(&prev.Vk.G2[1]))
+
+		// the proof survives serialization
+		var buf bytes.Buffer
+		_, err = proof.WriteTo(&buf)
+		assert.NoError(err)
+		var proofRead ContributionProof
+		_, err = proofRead.ReadFrom(&buf)
+		assert.NoError(err)
+
+		assert.NoError(VerifyContribution(prev, next, &proofRead))
+		prev = next
+	}
+
+	// the updated SRS is usable
+	f := randomPolynomial(size)
+	digest, err := Commit(f, prev.Pk)
+	assert.NoError(err)
+	var point fr.Element
+	point.SetRandom()
+	openingProof, err := Open(f, point, prev.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &openingProof, point, prev.Vk))
+
+	// malformed contributions
+	next := clone(srs)
+	proof, err := next.Contribute(crand.Reader)
+	assert.NoError(err)
+
+	tampered := clone(next)
+	tampered.Pk.G1[size/2].Double(&tampered.Pk.G1[size/2])
+	assert.Error(VerifyContribution(srs, tampered, &proof), "the progression is broken")
+
+	tampered = clone(next)
+	tampered.Pk.G1[size/2], tampered.Pk.G1[size/2+1] = tampered.Pk.G1[size/2+1], tampered.Pk.G1[size/2]
+	assert.Error(VerifyContribution(srs, tampered, &proof), "the progression is broken")
+
+	tampered = clone(next)
+	tampered.Pk.G1[0].Double(&tampered.Pk.G1[0])
+	tampered.Vk.G1 = tampered.Pk.G1[0]
+	assert.Error(VerifyContribution(srs, tampered, &proof), "the generator is modified")
+
+	tampered = clone(next)
+	tampered.Vk.Lines[1] = tampered.Vk.Lines[0]
+	assert.Error(VerifyContribution(srs, tampered, &proof), "the lines don't match")
+
+	// the proof is bound to the SRS it was computed on
+	other := clone(srs)
+	otherProof, err := other.Contribute(crand.Reader)
+	assert.NoError(err)
+	assert.Error(VerifyContribution(srs, next, &otherProof))
+	assert.Error(VerifyContribution(next, next, &proof))
+
+	// an SRS scaled without knowledge of the contribution: [α]G₂ is updated
+	// consistently, but the proof of knowledge is for another value
+	var s big.Int
+	s.SetUint64(3)
+	forged := clone(next)
+	forged.Vk.G2[1].ScalarMultiplication(&forged.Vk.G2[1], &s)
+	forged.Vk.Lines[1] = curve.PrecomputeLines(forged.Vk.G2[1])
+	var three fr.Element
+	three.SetUint64(3)
+	for i, e := 1, three; i < size; i++ {
+		var eInt big.Int
+		e.BigInt(&eInt)
+		forged.Pk.G1[i].ScalarMultiplication(&forged.Pk.G1[i], &eInt)
+		e.Mul(&e, &three)
+	}
+	assert.Error(VerifyContribution(srs, forged, &proof))
+}
+
 func TestToLagrangeG1(t *testing.T) {
 	assert := require.New(t)
 
@@ -156,6 +250,82 @@ func TestCommitLagrange(t *testing.T) {
 	t.Run("mpcsetup", test(mpcGetSrs(t)))
 }
 
+func TestCommitLagrangeProvingKey(t *testing.T) {
+	// sample a sparse polynomial (here in Lagrange form)
+	size := 64
+	pol := make([]fr.Element, size)
+	pol[0].MustSetRandom()
+	for i := 0; i < size; i = i + 8 {
+		pol[i].MustSetRandom()
+	}
+
+	test := func(srs *SRS) func(*testing.T) {
+		return func(t *testing.T) {
+			assert := require.New(t)
+
+			// commitment using Lagrange SRS
+			d := fft.NewDomain(uint64(size))
+			pkLagrange, err := NewProvingKeyLagrange(srs, d)
+			assert.NoError(err)
+
+			digestLagrange, err := CommitLagrange(pol, pkLagrange)
+			assert.NoError(err)
+
+			// commitment using canonical SRS
+			p := slices.Clone(pol)
+			d.FFTInverse(p, fft.DIF)
+			utils.BitReverse(p)
+			digestCanonical, err := Commit(p, srs.Pk)
+			assert.NoError(err)
+
+			// compare the results
+			assert.True(digestCanonical.Equal(&digestLagrange), "error CommitLagrange with ProvingKeyLagrange")
+		}
+	}
+	t.Run("unsafe", test(testSrs))
+	t.Run("mpcsetup", test(mpcGetSrs(t)))
+}
+
+func TestOpenLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 64
+	domain := fft.NewDomain(size)
+	pk, err := NewProvingKeyLagrange(testSrs, domain)
+	assert.NoError(err)
+
+	f := randomPolynomial(size)
+	evals := slices.Clone(f)
+	domain.FFT(evals, fft.DIF)
+	utils.BitReverse(evals)
+	evalsCopy := slices.Clone(evals)
+
+	digest, err := CommitLagrange(evals, pk)
+	assert.NoError(err)
+
+	var outside, inside fr.Element
+	outside.SetRandom()
+	inside.Exp(domain.Generator, big.NewInt(5))
+	var one fr.Element
+	one.SetOne()
+
+	for _, point := range []fr.Element{outside, inside, one} {
+		proof, err := OpenLagrange(evals, domain, point, pk)
+		assert.NoError(err)
+		assert.Equal(evalsCopy, evals, "evals must not be modified")
+
+		expected, err := Open(f, point, testSrs.Pk)
+		assert.NoError(err)
+		assert.True(proof.ClaimedValue.Equal(&expected.ClaimedValue), "wrong claimed value")
+		assert.True(proof.H.Equal(&expected.H), "wrong quotient commitment")
+
+		assert.NoError(Verify(&digest, &proof, point, testSrs.Vk))
+	}
+
+	_, err = OpenLagrange(evals[:size/2], domain, outside, pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
 func TestDividePolyByXminusA(t *testing.T) {
 
 	const pSize = 230
@@ -197,6 +367,46 @@ func TestDividePolyByXminusA(t *testing.T) {
 	}
 }
 
+func TestComputeQuotient(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{0, 1, 2, 60} {
+		p := randomPolynomial(size)
+		pCopy := slices.Clone(p)
+
+		var z fr.Element
+		z.SetRandom()
+		quotient, value := ComputeQuotient(p, z)
+		assert.Equal(pCopy, p, "p must not be modified")
+		expected := eval(p, z)
+		assert.True(value.Equal(&expected), "wrong evaluation")
+		if size <= 1 {
+			assert.Empty(quotient)
+			continue
+		}
+		assert.Len(quotient, size-1)
+
+		// quotient⋅(X-z) + value = p
+		reconstructed := make([]fr.Element, size)
+		var t fr.Element
+		for i := range quotient {
+			reconstructed[i+1].Add(&reconstructed[i+1], &quotient[i])
+			t.Mul(&quotient[i], &z)
+			reconstructed[i].Sub(&reconstructed[i], &t)
+		}
+		reconstructed[0].Add(&reconstructed[0], &value)
+		assert.Equal(p, reconstructed)
+
+		// the commitment of the quotient is the opening proof
+		proof, err := Open(p, z, testSrs.Pk)
+		assert.NoError(err)
+		h, err := Commit(quotient, testSrs.Pk)
+		assert.NoError(err)
+		assert.True(proof.H.Equal(&h))
+		assert.True(proof.ClaimedValue.Equal(&value))
+	}
+}
+
 func TestSerializationSRS(t *testing.T) {
 	// create a SRS
 	srs, err := NewSRS(64, new(big.Int).SetInt64(42))
@@ -208,6 +418,203 @@ func TestSerializationSRS(t *testing.T) {
 	t.Run("unsafe whole SRS round-trip", testutils.UnsafeBinaryMarshalerRoundTrip(srs))
 }
 
+func TestSerializationHeader(t *testing.T) {
+	assert := require.New(t)
+
+	var srsBuf bytes.Buffer
+	_, err := testSrs.WriteTo(&srsBuf)
+	assert.NoError(err)
+	data := srsBuf.Bytes()
+
+	t.Run("legacy SRS", func(t *testing.T) {
+		assert := require.New(t)
+		var legacy bytes.Buffer
+		_, err := testSrs.Pk.WriteTo(&legacy)
+		assert.NoError(err)
+		_, err = testSrs.Vk.WriteTo(&legacy)
+		assert.NoError(err)
+		assert.Equal(data[headerSize:], legacy.Bytes(), "the header should prefix the legacy encoding")
+
+		var srs SRS
+		n, err := srs.ReadFromLegacy(bytes.NewReader(legacy.Bytes()))
+		assert.NoError(err)
+		assert.Equal(int64(legacy.Len()), n)
+		assert.Equal(*testSrs, srs)
+
+		_, err = srs.UnsafeReadFromLegacy(bytes.NewReader(legacy.Bytes()))
+		assert.NoError(err)
+		assert.Equal(*testSrs, srs)
+
+		_, err = new(SRS).ReadFrom(bytes.NewReader(legacy.Bytes()))
+		assert.ErrorIs(err, ErrInvalidHeader)
+	})
+
+	t.Run("truncated header", func(t *testing.T) {
+		_, err := new(SRS).ReadFrom(bytes.NewReader(data[:headerSize-1]))
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		_, err = new(OpeningProof).ReadFrom(bytes.NewReader(nil))
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("wrong curve", func(t *testing.T) {
+		corrupted := slices.Clone(data)
+		binary.BigEndian.PutUint16(corrupted[6:8], uint16(curve.ID)+1)
+		_, err := new(SRS).ReadFrom(bytes.NewReader(corrupted))
+		require.ErrorIs(t, err, ErrCurveMismatch)
+		_, err = new(SRS).UnsafeReadFrom(bytes.NewReader(corrupted))
+		require.ErrorIs(t, err, ErrCurveMismatch)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		corrupted := slices.Clone(data)
+		binary.BigEndian.PutUint16(corrupted[4:6], encodingVersion+1)
+		_, err := new(SRS).ReadFrom(bytes.NewReader(corrupted))
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("opening proof", func(t *testing.T) {
+		assert := require.New(t)
+		f := randomPolynomial(60)
+		var point fr.Element
+		point.MustSetRandom()
+		proof, err := Open(f, point, testSrs.Pk)
+		assert.NoError(err)
+
+		var buf bytes.Buffer
+		written, err := proof.WriteTo(&buf)
+		assert.NoError(err)
+		encoded := buf.Bytes()
+
+		var decoded OpeningProof
+		read, err := decoded.ReadFrom(bytes.NewReader(encoded))
+		assert.NoError(err)
+		assert.Equal(written, read)
+		assert.Equal(proof, decoded)
+
+		decoded = OpeningProof{}
+		_, err = decoded.ReadFromLegacy(bytes.NewReader(encoded[headerSize:]))
+		assert.NoError(err)
+		assert.Equal(proof, decoded)
+
+		corrupted := slices.Clone(encoded)
+		binary.BigEndian.PutUint16(corrupted[6:8], uint16(curve.ID)+1)
+		_, err = decoded.ReadFrom(bytes.NewReader(corrupted))
+		assert.ErrorIs(err, ErrCurveMismatch)
+	})
+
+	t.Run("digest", func(t *testing.T) {
+		assert := require.New(t)
+		digest, err := Commit(randomPolynomial(60), testSrs.Pk)
+		assert.NoError(err)
+
+		var buf bytes.Buffer
+		written, err := WriteDigestTo(&buf, &digest)
+		assert.NoError(err)
+		encoded := buf.Bytes()
+
+		var decoded Digest
+		read, err := ReadDigestFrom(bytes.NewReader(encoded), &decoded)
+		assert.NoError(err)
+		assert.Equal(written, read)
+		assert.True(digest.Equal(&decoded))
+
+		decoded = Digest{}
+		_, err = ReadDigestFromLegacy(bytes.NewReader(encoded[headerSize:]), &decoded)
+		assert.NoError(err)
+		assert.True(digest.Equal(&decoded))
+
+		_, err = ReadDigestFrom(bytes.NewReader(encoded[headerSize:]), &decoded)
+		assert.ErrorIs(err, ErrInvalidHeader)
+	})
+}
+
+func TestReadFromStream(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := NewSRS(64, new(big.Int).SetInt64(42))
+	assert.NoError(err)
+
+	var compressed, raw bytes.Buffer
+	_, err = srs.Pk.WriteTo(&compressed)
+	assert.NoError(err)
+	_, err = srs.Pk.WriteRawTo(&raw)
+	assert.NoError(err)
+
+	for _, data := range [][]byte{compressed.Bytes(), raw.Bytes()} {
+		var pk ProvingKey
+		_, err = pk.ReadFrom(bytes.NewReader(data))
+		assert.NoError(err)
+
+		// streaming all the points visits them in order with the same values
+		var visited []curve.G1Affine
+		n, err := ReadFromStream(bytes.NewReader(data), uint64(len(pk.G1)), func(i uint64, p curve.G1Affine) error {
+			assert.Equal(uint64(len(visited)), i)
+			visited = append(visited, p)
+			return nil
+		})
+		assert.NoError(err)
+		assert.Equal(int64(len(data)), n)
+		assert.Equal(pk.G1, visited)
+
+		// a prefix, with no subgroup checks
+		visited = visited[:0]
+		_, err = ReadFromStream(bytes.NewReader(data), 10, func(i uint64, p curve.G1Affine) error {
+			visited = append(visited, p)
+			return nil
+		}, curve.NoSubgroupChecks())
+		assert.NoError(err)
+		assert.Equal(pk.G1[:10], visited)
+
+		// more points than available
+		_, err = ReadFromStream(bytes.NewReader(data), uint64(len(pk.G1))+1, func(i uint64, p curve.G1Affine) error {
+			return nil
+		})
+		assert.ErrorIs(err, ErrNotEnoughPoints)
+
+		// the visitor error is returned
+		errVisit := errors.New("visit")
+		_, err = ReadFromStream(bytes.NewReader(data), uint64(len(pk.G1)), func(i uint64, p curve.G1Affine) error {
+			if i == 3 {
+				return errVisit
+			}
+			return nil
+		})
+		assert.ErrorIs(err, errVisit)
+	}
+}
+
+func TestNewSRSFromSeed(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 32
+	srs1, err := NewSRSFromSeed(size, []byte("seed"))
+	assert.NoError(err)
+	srs2, err := NewSRSFromSeed(size, []byte("seed"))
+	assert.NoError(err)
+	srs3, err := NewSRSFromSeed(size, []byte("another seed"))
+	assert.NoError(err)
+
+	assert.Equal(srs1.Pk.G1, srs2.Pk.G1, "same seed should yield the same SRS")
+	assert.Equal(srs1.Vk.G2, srs2.Vk.G2, "same seed should yield the same SRS")
+	assert.NotEqual(srs1.Pk.G1[1], srs3.Pk.G1[1], "different seeds should yield different SRS")
+
+	_, err = NewSRSFromSeed(1, []byte("seed"))
+	assert.ErrorIs(err, ErrMinSRSSize)
+
+	// commitments and openings verify against the seeded SRS
+	f := randomPolynomial(size)
+	digest, err := Commit(f, srs1.Pk)
+	assert.NoError(err)
+	var point fr.Element
+	point.MustSetRandom()
+	proof, err := Open(f, point, srs1.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, srs1.Vk))
+
+	// but not against another one
+	assert.Error(Verify(&digest, &proof, point, srs3.Vk))
+}
+
 func TestCommit(t *testing.T) {
 
 	// create a polynomial
@@ -240,6 +647,95 @@ func TestCommit(t *testing.T) {
 	}
 }
 
+func TestCommitBatch(t *testing.T) {
+	assert := require.New(t)
+
+	polys := make([][]fr.Element, 5)
+	for i := range polys {
+		polys[i] = randomPolynomial(20 + 10*i)
+	}
+
+	digests, err := CommitBatch(polys, testSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(len(polys), len(digests))
+	for i := range polys {
+		expected, err := Commit(polys[i], testSrs.Pk)
+		assert.NoError(err)
+		assert.True(expected.Equal(&digests[i]), "wrong commitment %d", i)
+	}
+
+	// polynomial larger than the SRS
+	polys = append(polys, randomPolynomial(len(testSrs.Pk.G1)+1))
+	_, err = CommitBatch(polys, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestCommitConstant(t *testing.T) {
+	assert := require.New(t)
+
+	var c fr.Element
+	c.MustSetRandom()
+	for _, c := range []fr.Element{c, fr.NewElement(0), fr.One()} {
+		digest, err := CommitConstant(c, testSrs.Pk)
+		assert.NoError(err)
+		expected, err := Commit([]fr.Element{c}, testSrs.Pk)
+		assert.NoError(err)
+		assert.True(expected.Equal(&digest), "wrong commitment")
+	}
+
+	// empty proving key
+	_, err := CommitConstant(c, ProvingKey{})
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestVerifyChunked(t *testing.T) {
+	assert := require.New(t)
+
+	// polynomial larger than the (truncated) SRS
+	const chunkSize = 16
+	pk := ProvingKey{G1: testSrs.Pk.G1[:chunkSize]}
+	f := randomPolynomial(4*chunkSize - 4)
+
+	digests, err := CommitChunked(f, pk)
+	assert.NoError(err)
+	assert.Equal(4, len(digests))
+	for i := range digests {
+		expected, err := Commit(f[i*chunkSize:min((i+1)*chunkSize, len(f))], testSrs.Pk)
+		assert.NoError(err)
+		assert.True(expected.Equal(&digests[i]), "wrong commitment %d", i)
+	}
+
+	var point fr.Element
+	point.MustSetRandom()
+	proofs, err := OpenChunked(f, point, pk)
+	assert.NoError(err)
+
+	// the chunk openings combine into the evaluation of f
+	value, err := VerifyChunked(digests, proofs, point, chunkSize, testSrs.Vk)
+	assert.NoError(err)
+	expected := eval(f, point)
+	assert.True(value.Equal(&expected), "wrong evaluation")
+
+	// wrong chunk size: the chunk openings are valid, but don't combine into f
+	value, err = VerifyChunked(digests, proofs, point, chunkSize+1, testSrs.Vk)
+	assert.NoError(err)
+	assert.False(value.Equal(&expected), "wrong chunk size should not give the evaluation")
+
+	// wrong claimed value
+	proofs[1].ClaimedValue.Add(&proofs[1].ClaimedValue, &expected)
+	_, err = VerifyChunked(digests, proofs, point, chunkSize, testSrs.Vk)
+	assert.ErrorIs(err, ErrVerifyOpeningProof)
+
+	// missing chunk
+	_, err = VerifyChunked(digests[1:], proofs, point, chunkSize, testSrs.Vk)
+	assert.ErrorIs(err, ErrInvalidNbDigests)
+
+	// polynomial smaller than the SRS
+	digests, err = CommitChunked(f[:chunkSize-1], pk)
+	assert.NoError(err)
+	assert.Equal(1, len(digests))
+}
+
 func TestVerifySinglePoint(t *testing.T) {
 
 	// create a polynomial
@@ -305,6 +801,143 @@ func TestVerifySinglePoint(t *testing.T) {
 	t.Run("unsafe", test(testSrs))
 }
 
+func TestVerifyWithCommitmentHash(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(60)
+	digest, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+
+	var point fr.Element
+	point.SetString("4321")
+	proof, err := Open(f, point, testSrs.Pk)
+	assert.NoError(err)
+
+	commitmentHash := HashCommitment(&digest, sha256.New())
+	assert.Len(commitmentHash, sha256.Size)
+	assert.NoError(VerifyWithCommitmentHash(&digest, &proof, commitmentHash, point, testSrs.Vk, sha256.New()))
+
+	// a wrong claimed value is caught by the pairing check
+	wrongProof := proof
+	wrongProof.ClaimedValue.SetOne()
+	err = VerifyWithCommitmentHash(&digest, &wrongProof, commitmentHash, point, testSrs.Vk, sha256.New())
+	assert.ErrorIs(err, ErrVerifyOpeningProof)
+
+	// another commitment does not match the fingerprint
+	var otherDigest Digest
+	otherDigest.Add(&digest, &testSrs.Pk.G1[0])
+	err = VerifyWithCommitmentHash(&otherDigest, &proof, commitmentHash, point, testSrs.Vk, sha256.New())
+	assert.ErrorIs(err, ErrCommitmentHashMismatch)
+
+	// the fingerprint is checked before the proof: a mismatch is reported
+	// even with an invalid quotient and an empty verifying key, which would
+	// fail the subgroup or pairing checks
+	invalidProof := proof
+	invalidProof.H.X.SetOne()
+	tamperedHash := slices.Clone(commitmentHash)
+	tamperedHash[0] ^= 1
+	err = VerifyWithCommitmentHash(&digest, &invalidProof, tamperedHash, point, VerifyingKey{}, sha256.New())
+	assert.ErrorIs(err, ErrCommitmentHashMismatch)
+	err = VerifyWithCommitmentHash(&digest, &invalidProof, commitmentHash, point, testSrs.Vk, sha256.New())
+	assert.ErrorIs(err, ErrQuotientNotNotInSubgroup)
+}
+
+func TestVerifyMultiPoint(t *testing.T) {
+	assert := require.New(t)
+
+	points := make([]fr.Element, 5)
+	for i := range points {
+		points[i].SetRandom()
+	}
+
+	test := func(f []fr.Element) {
+		digest, err := Commit(f, testSrs.Pk)
+		assert.NoError(err)
+
+		proof, err := OpenMultiPoint(f, points, testSrs.Pk)
+		assert.NoError(err)
+		for i := range points {
+			expected := eval(f, points[i])
+			assert.True(proof.ClaimedValues[i].Equal(&expected), "inconsistent claimed value")
+		}
+		assert.NoError(VerifyMultiPoint(&digest, &proof, points, testSrs.Vk))
+
+		// serialization round trip
+		var buf bytes.Buffer
+		_, err = proof.WriteTo(&buf)
+		assert.NoError(err)
+		var decoded MultiPointProof
+		_, err = decoded.ReadFrom(&buf)
+		assert.NoError(err)
+		assert.NoError(VerifyMultiPoint(&digest, &decoded, points, testSrs.Vk))
+
+		// tampering with any claimed value must be detected
+		var one fr.Element
+		one.SetOne()
+		for i := range proof.ClaimedValues {
+			proof.ClaimedValues[i].Add(&proof.ClaimedValues[i], &one)
+			assert.Error(VerifyMultiPoint(&digest, &proof, points, testSrs.Vk), "verifying wrong proof should have failed")
+			proof.ClaimedValues[i].Sub(&proof.ClaimedValues[i], &one)
+		}
+
+		// wrong number of claimed values
+		assert.ErrorIs(VerifyMultiPoint(&digest, &proof, points[1:], testSrs.Vk), ErrInvalidNbPoints)
+	}
+
+	test(randomPolynomial(60))
+	// fewer coefficients than points, the quotient is zero
+	test(randomPolynomial(3))
+
+	// repeated point
+	f := randomPolynomial(60)
+	repeated := []fr.Element{points[0], points[1], points[0]}
+	_, err := OpenMultiPoint(f, repeated, testSrs.Pk)
+	assert.ErrorIs(err, ErrDuplicatePoint)
+	var digest Digest
+	proof := MultiPointProof{ClaimedValues: make([]fr.Element, len(repeated))}
+	assert.ErrorIs(VerifyMultiPoint(&digest, &proof, repeated, testSrs.Vk), ErrDuplicatePoint)
+}
+
+func TestOpenAllPoints(t *testing.T) {
+
+	test := func(srs *SRS) func(*testing.T) {
+		return func(t *testing.T) {
+			assert := require.New(t)
+
+			const size = 32
+			domain := fft.NewDomain(size)
+
+			for _, degree := range []int{0, 1, 20, size - 1} {
+				f := randomPolynomial(degree + 1)
+				digest, err := Commit(f, srs.Pk)
+				assert.NoError(err)
+
+				proofs, err := OpenAllPoints(f, domain, srs.Pk)
+				assert.NoError(err)
+				assert.Equal(size, len(proofs))
+
+				// compare a few of the batch proofs with individual openings
+				for _, i := range []int{0, 1, 7, size - 1} {
+					var point fr.Element
+					point.Exp(domain.Generator, big.NewInt(int64(i)))
+
+					expected, err := Open(f, point, srs.Pk)
+					assert.NoError(err)
+					assert.True(expected.ClaimedValue.Equal(&proofs[i].ClaimedValue), "wrong claimed value at %d", i)
+					assert.True(expected.H.Equal(&proofs[i].H), "wrong quotient at %d", i)
+					assert.NoError(Verify(&digest, &proofs[i], point, srs.Vk))
+				}
+			}
+
+			// polynomial larger than the domain
+			_, err := OpenAllPoints(randomPolynomial(size+1), domain, srs.Pk)
+			assert.ErrorIs(err, ErrInvalidPolynomialSize)
+		}
+	}
+	t.Run("unsafe", test(testSrs))
+	t.Run("mpcsetup", test(mpcGetSrs(t)))
+}
+
 func TestVerifySinglePointQuickSRS(t *testing.T) {
 
 	size := 64
@@ -583,6 +1216,44 @@ func BenchmarkKZGCommit(b *testing.B) {
 	})
 }
 
+func BenchmarkKZGCommitBatch(b *testing.B) {
+	const nbPolys = 8
+	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), big.NewInt(-1))
+	assert.NoError(b, err)
+	polys := make([][]fr.Element, nbPolys)
+	for i := range polys {
+		polys[i] = randomPolynomial(benchSize)
+	}
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = CommitBatch(polys, srs.Pk)
+		}
+	})
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range polys {
+				_, _ = Commit(polys[j], srs.Pk)
+			}
+		}
+	})
+}
+
+func BenchmarkKZGCommitLagrange(b *testing.B) {
+	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+	assert.NoError(b, err)
+	d := fft.NewDomain(ecc.NextPowerOfTwo(benchSize))
+	pk, err := NewProvingKeyLagrange(srs, d)
+	assert.NoError(b, err)
+	// random evaluations
+	evals := randomPolynomial(int(d.Cardinality))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = CommitLagrange(evals, pk)
+	}
+}
+
 func BenchmarkDivideByXMinusA(b *testing.B) {
 	const pSize = 1 << 22
 
@@ -619,6 +1290,17 @@ func BenchmarkKZGOpen(b *testing.B) {
 	}
 }
 
+func BenchmarkKZGOpenAllPoints(b *testing.B) {
+	const size = 64
+	domain := fft.NewDomain(size)
+	p := randomPolynomial(size)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = OpenAllPoints(p, domain, testSrs.Pk)
+	}
+}
+
 func BenchmarkKZGVerify(b *testing.B) {
 	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
 	assert.NoError(b, err)
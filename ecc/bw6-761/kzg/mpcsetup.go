@@ -169,3 +169,97 @@ func (s *MpcSetup) Seal(beaconChallenge []byte) SRS {
 
 	return s.srs
 }
+
+// ContributionProof proves that an SRS was updated by Contribute with a
+// contribution known to the contributor.
+//
+// implements io.ReaderFrom and io.WriterTo
+type ContributionProof struct {
+	mpcsetup.UpdateProof
+}
+
+// Contribute applies a secret contribution τ' drawn from rng to the SRS, in
+// place: [αⁱ]G₁ becomes [(α⋅τ')ⁱ]G₁ and [α]G₂ becomes [α⋅τ']G₂. The returned
+// proof binds the update to the SRS it was applied to, see VerifyContribution.
+//
+// τ' is not returned: the setup is secure as long as one of the contributors
+// used an unpredictable rng and did not keep τ'.
+func (srs *SRS) Contribute(rng io.Reader) (ContributionProof, error) {
+	if len(srs.Pk.G1) < 2 {
+		return ContributionProof{}, ErrMinSRSSize
+	}
+	challenge, err := srs.contributionChallenge()
+	if err != nil {
+		return ContributionProof{}, err
+	}
+
+	var contribution fr.Element
+	for contribution.IsZero() {
+		if _, err = contribution.SetRandomFrom(rng); err != nil {
+			return ContributionProof{}, err
+		}
+	}
+
+	var proof ContributionProof
+	proof.UpdateProof = mpcsetup.UpdateValues(&contribution, challenge, 0, &srs.Vk.G2[1])
+	mpcsetup.UpdateMonomialsG1(srs.Pk.G1, &contribution)
+	srs.Vk.Lines[1] = curve.PrecomputeLines(srs.Vk.G2[1])
+	contribution.SetZero()
+
+	return proof, nil
+}
+
+// VerifyContribution checks that next was obtained from previous by Contribute,
+// proof being the returned ContributionProof:
+//   - the generators [1]G₁, [1]G₂ are unchanged and all the points are in the subgroups
+//   - the contributor knows τ' such that [α']G₂ = τ'⋅[α]G₂
+//   - the points [α'ⁱ]G₁ form a geometric progression of ratio α', checked with pairings
+func VerifyContribution(previous, next *SRS, proof *ContributionProof) error {
+	n := len(next.Pk.G1)
+	if n < 2 || len(previous.Pk.G1) != n {
+		return errors.New("the SRS sizes don't match")
+	}
+	if !next.Pk.G1[0].Equal(&previous.Pk.G1[0]) || !next.Vk.G1.Equal(&previous.Vk.G1) || !next.Vk.G1.Equal(&next.Pk.G1[0]) {
+		return errors.New("the G₁ generator was modified")
+	}
+	if !next.Vk.G2[0].Equal(&previous.Vk.G2[0]) {
+		return errors.New("the G₂ generator was modified")
+	}
+	if !next.Vk.G2[1].IsInSubGroup() {
+		return errors.New("[α]₂ representation not in subgroup")
+	}
+	if !curve.IsInSubGroupBatchG1(next.Pk.G1) {
+		return errors.New("[αⁱ]₁ representations not in subgroup")
+	}
+
+	challenge, err := previous.contributionChallenge()
+	if err != nil {
+		return err
+	}
+	if err = proof.Verify(challenge, 0, mpcsetup.ValueUpdate{
+		Previous: previous.Vk.G2[1],
+		Next:     next.Vk.G2[1],
+	}); err != nil {
+		return err
+	}
+
+	if err = mpcsetup.SameRatioMany(next.Pk.G1, next.Vk.G2[:]); err != nil {
+		return fmt.Errorf("[αⁱ]₁ representations are not a geometric progression: %w", err)
+	}
+
+	if next.Vk.Lines[0] != previous.Vk.Lines[0] || next.Vk.Lines[1] != curve.PrecomputeLines(next.Vk.G2[1]) {
+		return errors.New("the precomputed pairing lines don't match G₂ and [α]G₂")
+	}
+	return nil
+}
+
+// contributionChallenge returns the challenge binding a contribution proof to
+// the SRS being updated.
+func (srs *SRS) contributionChallenge() ([]byte, error) {
+	hsh := sha256.New()
+	hsh.Write([]byte("KZG SRS contribution"))
+	if _, err := srs.WriteRawTo(hsh); err != nil {
+		return nil, err
+	}
+	return hsh.Sum(nil), nil
+}
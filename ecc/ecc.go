@@ -15,6 +15,8 @@
 //   - EdDSA (on the "companion" twisted edwards curves)
 package ecc
 
+import "sync"
+
 // ID represent a unique ID for a curve
 type ID uint16
 
@@ -36,5 +38,59 @@ const (
 
 // MultiExpConfig enables to set optional configuration attribute to a call to MultiExp
 type MultiExpConfig struct {
-	NbTasks int // go routines to be used in the multiexp. can be larger than num cpus.
+	NbTasks            int       // go routines to be used in the multiexp. can be larger than num cpus.
+	ChunkSize          int       // window size (in bits) of the bucket method; 0 (auto) or a size without implementation (see MultiExp) selects it heuristically
+	Stats              *MSMStats // if not nil, collects bucket statistics of the multiexp (telemetry, to tune the window size)
+	SparseOptimization bool      // if true, points with a zero scalar are skipped and points with a scalar one are added directly, the bucket method running on the others
+}
+
+// MSMWindowStats holds the statistics of one window (chunk) of a MultiExp.
+type MSMWindowStats struct {
+	C               uint64 // window size in bits
+	NbBuckets       int    // number of available buckets in the window
+	NbBucketsFilled int    // number of buckets hit by at least one point
+	NbAdditions     int    // number of points accumulated in the buckets
+}
+
+// MSMStats records statistics of MultiExp calls.
+//
+// A MultiExp may be split in several concurrent sub-MultiExps, hence the
+// statistics of all windows processed are appended; MSMStats is safe for
+// concurrent use.
+type MSMStats struct {
+	lock    sync.Mutex
+	windows []MSMWindowStats
+}
+
+// AddWindow records the statistics of a processed window.
+func (s *MSMStats) AddWindow(w MSMWindowStats) {
+	s.lock.Lock()
+	s.windows = append(s.windows, w)
+	s.lock.Unlock()
+}
+
+// Windows returns a copy of the recorded window statistics.
+func (s *MSMStats) Windows() []MSMWindowStats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]MSMWindowStats(nil), s.windows...)
+}
+
+// NbAdditions returns the total number of points accumulated in the buckets
+// over all recorded windows.
+func (s *MSMStats) NbAdditions() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	n := 0
+	for _, w := range s.windows {
+		n += w.NbAdditions
+	}
+	return n
+}
+
+// Reset clears the recorded statistics.
+func (s *MSMStats) Reset() {
+	s.lock.Lock()
+	s.windows = nil
+	s.lock.Unlock()
 }
@@ -172,7 +172,16 @@ func TestVectorOps(t *testing.T) {
 			innerProduct.Add(&innerProduct, &tmp)
 		}
 
-		return innerProduct.Equal(&computed)
+		computed2, err := InnerProduct(a, b)
+		if err != nil {
+			return false
+		}
+
+		// the generic implementation, used when there is no assembly
+		var generic Element
+		innerProductVecGeneric(&generic, a, b)
+
+		return innerProduct.Equal(&computed) && innerProduct.Equal(&computed2) && innerProduct.Equal(&generic)
 	}
 
 	mulVector := func(a, b Vector) bool {
@@ -245,6 +254,103 @@ func TestVectorOps(t *testing.T) {
 	properties.TestingRun(t, gopter.NewFormatedReporter(false, 260, os.Stdout))
 }
 
+func TestInnerProductLength(t *testing.T) {
+	a := make([]Element, 3)
+	b := make([]Element, 4)
+	if _, err := InnerProduct(a, b); err == nil {
+		t.Fatal("InnerProduct should fail on vectors of different lengths")
+	}
+	res, err := InnerProduct(nil, nil)
+	if err != nil || !res.IsZero() {
+		t.Fatal("InnerProduct of empty vectors should be zero")
+	}
+}
+
+func TestBatchMont(t *testing.T) {
+	n := 1 << 20
+	if testing.Short() {
+		n = 1 << 16
+	}
+	var mixer Element
+	mixer.MustSetRandom()
+	v := make(Vector, n)
+	for i := range v {
+		v[i].SetUint64(uint64(i)).Mul(&v[i], &mixer)
+	}
+	original := make(Vector, n)
+	copy(original, v)
+
+	BatchFromMont(v)
+	for i := range v {
+		expected := original[i]
+		expected.fromMont()
+		if v[i] != expected {
+			t.Fatalf("BatchFromMont: mismatch at index %d", i)
+		}
+	}
+
+	BatchToMont(v)
+	if !v.Equal(original) {
+		t.Fatal("BatchToMont should invert BatchFromMont")
+	}
+
+	// empty and small slices
+	BatchFromMont(nil)
+	small := original[:3]
+	BatchFromMont(small)
+	BatchToMont(small)
+	if !Vector(small).Equal(original[:3]) {
+		t.Fatal("BatchToMont should invert BatchFromMont on small slices")
+	}
+}
+
+func TestInnerProductGeneric(t *testing.T) {
+	// large elements, to exercise the carries of the lazy accumulation
+	const n = 4096
+	a := make(Vector, n)
+	b := make(Vector, n)
+	for i := range a {
+		a[i].SetInt64(-1)
+		b[i].SetInt64(-int64(i) - 1)
+	}
+
+	var expected, tmp Element
+	for i := range a {
+		tmp.Mul(&a[i], &b[i])
+		expected.Add(&expected, &tmp)
+	}
+
+	var res Element
+	innerProductVecGeneric(&res, a, b)
+	if !res.Equal(&expected) {
+		t.Fatal("innerProductVecGeneric doesn't match the naive inner product")
+	}
+}
+
+func BenchmarkInnerProduct(b *testing.B) {
+	const n = 4096
+	a1 := make(Vector, n)
+	b1 := make(Vector, n)
+	a1.MustSetRandom()
+	b1.MustSetRandom()
+
+	b.Run("naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var res, tmp Element
+			for j := 0; j < n; j++ {
+				tmp.Mul(&a1[j], &b1[j])
+				res.Add(&res, &tmp)
+			}
+		}
+	})
+
+	b.Run("InnerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = InnerProduct(a1, b1)
+		}
+	})
+}
+
 func BenchmarkVectorOps(b *testing.B) {
 	// note; to benchmark against "no asm" version, use the following
 	// build tag: -tags purego
@@ -52,6 +52,28 @@ func TestECDSA(t *testing.T) {
 		},
 	))
 
+	properties.Property("[SECP256K1] test the deterministic (RFC 6979) signing and verification", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig1, err := privKey.SignRFC6979(msg, hFunc)
+			if err != nil {
+				return false
+			}
+			sig2, err := privKey.SignRFC6979(msg, hFunc)
+			if err != nil {
+				return false
+			}
+			flag, _ := publicKey.Verify(sig1.Bytes(), msg, hFunc)
+
+			return flag && sig1 == sig2
+		},
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 func TestRecoverPublicKey(t *testing.T) {
@@ -80,6 +102,76 @@ func TestRecoverPublicKey(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestSignRFC6979Vectors(t *testing.T) {
+	t.Parallel()
+
+	// secp256k1 / SHA-256 test vectors for the RFC 6979 nonces, as used in
+	// Bitcoin implementations, with low-s signatures.
+	testCases := []struct {
+		sk, msg, k, r, s string
+	}{
+		{
+			sk:  "1",
+			msg: "Satoshi Nakamoto",
+			k:   "8f8a276c19f4149656b280621e358cce24f5f52542772691ee69063b74f15d15",
+			r:   "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8",
+			s:   "2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5",
+		},
+		{
+			sk:  "1",
+			msg: "All those moments will be lost in time, like tears in rain. Time to die...",
+			k:   "38aa22d72376b4dbc472e06c3ba403ee0a394da63fc58d88686c611aba98d6b3",
+			r:   "8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b",
+			s:   "547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21",
+		},
+		{
+			sk:  "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140",
+			msg: "Satoshi Nakamoto",
+			k:   "33a19b60e25fb6f4435af53a3d42d493644827367e6453928554f43e49aa6f90",
+			r:   "fd567d121db66e382991534ada77a6bd3106f0a1098c231e47993447cd6af2d0",
+			s:   "6b39cd0eb1bc8603e159ef5c20a5c8ad685a45b06ce9bebed3f153d10d93bed5",
+		},
+		{
+			sk:  "f8b8af8ce3c7cca5e300d33939540c10d45ce001b8f252bfbc57ba0342904181",
+			msg: "Alan Turing",
+			k:   "525a82b70e67874398067543fd84c83d30c175fdc45fdeee082fe13b1d7cfdf1",
+			r:   "7063ae83e7f62bbb171798131b4a0564b956930092b33b07b395615d9ec7e15c",
+			s:   "58dfcc1e00a35e1572f366ffe34ba0fc47db1e7189759b9fb233c5b05ab388ea",
+		},
+	}
+
+	for _, tc := range testCases {
+		var privKey PrivateKey
+		sk, _ := new(big.Int).SetString(tc.sk, 16)
+		sk.FillBytes(privKey.scalar[:sizeFr])
+
+		hFunc := sha256.New()
+		hFunc.Write([]byte(tc.msg))
+		hramBin := hFunc.Sum(nil)
+		drbg := newHMACDRBG(hFunc, privKey.scalar[:sizeFr], bits2octets(hramBin))
+		if k := drbg.nonce(); k.Text(16) != tc.k {
+			t.Fatalf("%q: wrong nonce %s", tc.msg, k.Text(16))
+		}
+
+		sig, err := privKey.SignRFC6979([]byte(tc.msg), hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := new(big.Int).SetBytes(sig.R[:sizeFr])
+		s := new(big.Int).SetBytes(sig.S[:sizeFr])
+		if r.Text(16) != tc.r || s.Text(16) != tc.s {
+			t.Fatalf("%q: wrong signature (%s, %s)", tc.msg, r.Text(16), s.Text(16))
+		}
+	}
+
+	// a nil hash function is rejected
+	var privKey PrivateKey
+	privKey.scalar[sizeFr-1] = 1
+	if _, err := privKey.SignRFC6979([]byte("Satoshi Nakamoto"), nil); err != errNilHash {
+		t.Fatal("should raise nil hash error")
+	}
+}
+
 func TestNonMalleability(t *testing.T) {
 
 	// buffer too big
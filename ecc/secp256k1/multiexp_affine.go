@@ -33,6 +33,28 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 		<-sem
 	}
 
+	var total [1]g1JacExtended
+	accumulateChunkG1BatchAffine[BJE, B, BS, TP, TPP, TQ, TC](points, digits, []int{0, len(digits)}, total[:])
+
+	if sem != nil {
+		// release a token to the semaphore
+		// before sending to chRes
+		sem <- struct{}{}
+	}
+
+	chRes <- total[0]
+}
+
+// accumulateChunkG1BatchAffine is the bucket accumulation of processChunkG1BatchAffine,
+// for the digits of one or several scalar vectors: the vector k has its digits in
+// digits[offsets[k]:offsets[k+1]], and its partial sum is stored in totals[k].
+// The buckets and the batch are set up once, and reused from one vector to the next.
+func accumulateChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP pG1Affine, TPP ppG1Affine, TQ qOpsG1Affine, TC cG1Affine](
+	points []G1Affine,
+	digits []uint16,
+	offsets []int,
+	totals []g1JacExtended) {
+
 	// the batch affine addition needs independent points; in other words, for a window of batchSize
 	// we want to hit independent bucketIDs when processing the digit. if there is a conflict (we're trying
 	// to add 2 different points to the same bucket), then we push the conflicted point to a queue.
@@ -50,9 +72,6 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	// 1 in g1JacExtended used in case the queue of conflicting points
 	var buckets B // in G1Affine coordinates, infinity point is represented as (0,0), no need to init
 	var bucketsJE BJE
-	for i := 0; i < len(buckets); i++ {
-		bucketsJE[i].SetInfinity()
-	}
 
 	// setup for the batch affine;
 	var (
@@ -162,71 +181,74 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 		}
 	}
 
-	for i, digit := range digits {
-
-		if digit == 0 || points[i].IsInfinity() {
-			continue
+	for k := range totals {
+		if k != 0 {
+			// reset the buckets of the previous vector
+			for i := 0; i < len(buckets); i++ {
+				buckets[i].SetInfinity()
+			}
 		}
-
-		bucketID := uint16((digit >> 1))
-		isAdd := digit&1 == 0
-		if isAdd {
-			// add
-			bucketID -= 1
+		for i := 0; i < len(bucketsJE); i++ {
+			bucketsJE[i].SetInfinity()
 		}
 
-		if bucketIds[bucketID] {
-			// put it in queue
-			queue[qID].bucketID = bucketID
+		for i, digit := range digits[offsets[k]:offsets[k+1]] {
+
+			if digit == 0 || points[i].IsInfinity() {
+				continue
+			}
+
+			bucketID := uint16((digit >> 1))
+			isAdd := digit&1 == 0
 			if isAdd {
-				queue[qID].point.Set(&points[i])
-			} else {
-				queue[qID].point.Neg(&points[i])
+				// add
+				bucketID -= 1
 			}
-			qID++
 
-			// queue is full, flush it.
-			if qID == len(queue)-1 {
-				flushQueue()
+			if bucketIds[bucketID] {
+				// put it in queue
+				queue[qID].bucketID = bucketID
+				if isAdd {
+					queue[qID].point.Set(&points[i])
+				} else {
+					queue[qID].point.Neg(&points[i])
+				}
+				qID++
+
+				// queue is full, flush it.
+				if qID == len(queue)-1 {
+					flushQueue()
+				}
+				continue
 			}
-			continue
-		}
 
-		// we add the point to the batch.
-		add(bucketID, &points[i], isAdd)
-		if isFull() {
-			executeAndReset()
-			processTopQueue()
+			// we add the point to the batch.
+			add(bucketID, &points[i], isAdd)
+			if isFull() {
+				executeAndReset()
+				processTopQueue()
+			}
 		}
-	}
 
-	// flush items in batch.
-	executeAndReset()
-
-	// empty the queue
-	flushQueue()
-
-	// reduce buckets into total
-	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
-	var runningSum, total g1JacExtended
-	runningSum.SetInfinity()
-	total.SetInfinity()
-	for k := len(buckets) - 1; k >= 0; k-- {
-		runningSum.addMixed(&buckets[k])
-		if !bucketsJE[k].IsInfinity() {
-			runningSum.add(&bucketsJE[k])
+		// flush items in batch.
+		executeAndReset()
+
+		// empty the queue
+		flushQueue()
+
+		// reduce buckets into total
+		// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+		var runningSum g1JacExtended
+		runningSum.SetInfinity()
+		totals[k].SetInfinity()
+		for l := len(buckets) - 1; l >= 0; l-- {
+			runningSum.addMixed(&buckets[l])
+			if !bucketsJE[l].IsInfinity() {
+				runningSum.add(&bucketsJE[l])
+			}
+			totals[k].add(&runningSum)
 		}
-		total.add(&runningSum)
 	}
-
-	if sem != nil {
-		// release a token to the semaphore
-		// before sending to chRes
-		sem <- struct{}{}
-	}
-
-	chRes <- total
-
 }
 
 // we declare the buckets as fixed-size array types
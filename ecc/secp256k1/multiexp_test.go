@@ -218,6 +218,271 @@ func TestMultiExpG1(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestMultiExpNaiveG1(t *testing.T) {
+	const nbSamples = 1 << 8
+	var samplePoints [nbSamples]G1Affine
+	var sampleScalars [nbSamples]fr.Element
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+		sampleScalars[i].MustSetRandom()
+	}
+	samplePoints[rand.N(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	sampleScalars[rand.N(nbSamples)].SetZero()    //#nosec G404 weak rng is fine here
+
+	// naive accumulation of the scalar multiplications
+	var expected, tmp G1Jac
+	var s big.Int
+	for i := range samplePoints {
+		tmp.FromAffine(&samplePoints[i])
+		tmp.ScalarMultiplication(&tmp, sampleScalars[i].BigInt(&s))
+		expected.AddAssign(&tmp)
+	}
+
+	var r G1Jac
+	if _, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(&expected) {
+		t.Fatal("msm doesn't match the naive accumulation")
+	}
+}
+
+func TestMultiExpSparseG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[rand.N(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+
+	// a third of zeros, a third of ones and a third of random scalars, then
+	// only zeros and ones, then only zeros
+	var sampleScalars [nbSamples]fr.Element
+	for i := range sampleScalars {
+		switch rand.N(3) { //#nosec G404 weak rng is fine here
+		case 0:
+			sampleScalars[i].SetZero()
+		case 1:
+			sampleScalars[i].SetOne()
+		default:
+			sampleScalars[i].MustSetRandom()
+		}
+	}
+	var zerosAndOnes, zeros [nbSamples]fr.Element
+	for i := range zerosAndOnes {
+		if rand.N(2) == 0 { //#nosec G404 weak rng is fine here
+			zerosAndOnes[i].SetOne()
+		}
+	}
+
+	for _, scalars := range [][]fr.Element{sampleScalars[:], zerosAndOnes[:], zeros[:]} {
+		var expected, r G1Jac
+		if _, err := expected.MultiExp(samplePoints[:], scalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.MultiExp(samplePoints[:], scalars, ecc.MultiExpConfig{SparseOptimization: true}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatal("sparse multiexp differs from the default one")
+		}
+	}
+}
+
+func TestMultiExpChunkSizeG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected G1Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+	chunkSizes := []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	if testing.Short() {
+		chunkSizes = []int{5, 14}
+	}
+	// out of range values fall back to the heuristic
+	chunkSizes = append(chunkSizes, -1, 1, 3, 17)
+
+	for _, c := range chunkSizes {
+		var r G1Jac
+		if _, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{ChunkSize: c}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatalf("multiexp with chunk size %d differs from the default one", c)
+		}
+	}
+}
+
+func TestMultiExpNbTasksG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected G1Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: 1})
+
+	// the windows are reduced concurrently, the result must not depend on the number of tasks
+	for _, nbTasks := range []int{2, 3, runtime.NumCPU(), 2 * runtime.NumCPU(), 1024} {
+		var r G1Jac
+		if _, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatalf("multiexp with %d tasks differs from the single task one", nbTasks)
+		}
+	}
+}
+
+func TestMultiExpJacobianG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	// points in Jacobian coordinates, with Z ≠ 1, and a point at infinity
+	var samplePoints [nbSamples]G1Jac
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i < nbSamples; i++ {
+		samplePoints[i] = g
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[0].X.SetOne()
+	samplePoints[0].Y.SetOne()
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	affinePoints := make([]G1Affine, nbSamples)
+	for i := range samplePoints {
+		affinePoints[i].FromJacobian(&samplePoints[i])
+	}
+	var expected G1Jac
+	expected.MultiExp(affinePoints, sampleScalars[:], ecc.MultiExpConfig{})
+
+	var r G1Jac
+	if _, err := r.MultiExpJacobian(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(&expected) {
+		t.Fatal("multiexp of Jacobian points differs from multiexp of the affine points")
+	}
+
+	if _, err := r.MultiExpJacobian(samplePoints[1:], sampleScalars[:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error for len(points) != len(scalars)")
+	}
+}
+
+func TestMultiExpBatchG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	samplePoints := make([]G1Affine, nbSamples)
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := range samplePoints {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+
+	// vectors of different lengths, including an empty one
+	scalars := [][]fr.Element{
+		make([]fr.Element, nbSamples),
+		make([]fr.Element, nbSamples/2+1),
+		make([]fr.Element, 0),
+		make([]fr.Element, 3),
+	}
+	for k := range scalars {
+		for i := range scalars[k] {
+			scalars[k][i].SetRandom()
+		}
+	}
+
+	// c = 10 fills enough buckets for the batch affine accumulation
+	for _, c := range []int{0, 5, 10, 16} {
+		res, err := MultiExpBatchG1(samplePoints, scalars, ecc.MultiExpConfig{ChunkSize: c})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k := range scalars {
+			var expected G1Affine
+			expected.MultiExp(samplePoints[:len(scalars[k])], scalars[k], ecc.MultiExpConfig{})
+			if !res[k].Equal(&expected) {
+				t.Fatalf("batch multiexp (c=%d) differs from multiexp for the vector %d", c, k)
+			}
+		}
+	}
+
+	if _, err := MultiExpBatchG1(samplePoints[:2], scalars, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error for len(scalars[k]) > len(points)")
+	}
+}
+
+func TestMultiExpStatsG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected, r G1Jac
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+	var stats ecc.MSMStats
+	for _, nbTasks := range []int{1, 64} {
+		stats.Reset()
+		if _, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: nbTasks, Stats: &stats}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatal("multiexp with stats differs from multiexp without stats")
+		}
+
+		windows := stats.Windows()
+		if len(windows) == 0 {
+			t.Fatal("no window recorded")
+		}
+		total := 0
+		for i, w := range windows {
+			if w.NbBuckets != 1<<(w.C-1) {
+				t.Fatalf("window %d: expected %d buckets, got %d", i, 1<<(w.C-1), w.NbBuckets)
+			}
+			if w.NbAdditions > nbSamples {
+				t.Fatalf("window %d: more additions (%d) than points", i, w.NbAdditions)
+			}
+			if w.NbBucketsFilled > w.NbBuckets || w.NbBucketsFilled > w.NbAdditions {
+				t.Fatalf("window %d: implausible number of filled buckets (%d)", i, w.NbBucketsFilled)
+			}
+			if w.NbAdditions != 0 && w.NbBucketsFilled == 0 {
+				t.Fatalf("window %d: additions recorded but no bucket filled", i)
+			}
+			total += w.NbAdditions
+		}
+		if total == 0 || total != stats.NbAdditions() {
+			t.Fatalf("unexpected total number of additions %d", total)
+		}
+	}
+}
+
 func TestCrossMultiExpG1(t *testing.T) {
 	const nbSamples = 1 << 14
 	// multi exp points
@@ -363,6 +628,143 @@ func BenchmarkMultiExpG1(b *testing.B) {
 	}
 }
 
+func BenchmarkMultiExpChunkSizeG1(b *testing.B) {
+	const nbSamples = 1 << 18
+
+	var (
+		samplePoints  [nbSamples]G1Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG1(samplePoints[:])
+
+	var testPoint G1Affine
+
+	chunkSizes := []int{0, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	for _, c := range chunkSizes {
+		b.Run(fmt.Sprintf("c=%d", c), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{ChunkSize: c})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpNbTasksG1(b *testing.B) {
+	const nbSamples = 1 << 22
+
+	var (
+		samplePoints  [nbSamples]G1Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG1(samplePoints[:])
+
+	var testPoint G1Affine
+
+	for nbTasks := 1; nbTasks <= runtime.NumCPU(); nbTasks *= 2 {
+		b.Run(fmt.Sprintf("nbTasks=%d", nbTasks), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: nbTasks})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpJacobianG1(b *testing.B) {
+	const nbSamples = 1 << 16
+
+	samplePoints := make([]G1Jac, nbSamples)
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := range samplePoints {
+		samplePoints[i] = g
+		g.AddAssign(&g1Gen)
+	}
+	sampleScalars := make([]fr.Element, nbSamples)
+	fillBenchScalars(sampleScalars)
+
+	var r G1Jac
+	b.Run("convert-then-multiexp", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			affinePoints := make([]G1Affine, nbSamples)
+			for i := range samplePoints {
+				affinePoints[i].FromJacobian(&samplePoints[i])
+			}
+			r.MultiExp(affinePoints, sampleScalars, ecc.MultiExpConfig{})
+		}
+	})
+	b.Run("multiexp-jacobian", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			r.MultiExpJacobian(samplePoints, sampleScalars, ecc.MultiExpConfig{})
+		}
+	})
+}
+
+func BenchmarkMultiExpBatchG1(b *testing.B) {
+	const (
+		nbSamples = 1 << 16
+		nbVectors = 4
+	)
+
+	samplePoints := make([]G1Affine, nbSamples)
+	fillBenchBasesG1(samplePoints)
+	scalars := make([][]fr.Element, nbVectors)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars[k])
+	}
+
+	b.Run("independent", func(b *testing.B) {
+		res := make([]G1Affine, nbVectors)
+		for j := 0; j < b.N; j++ {
+			for k := range scalars {
+				res[k].MultiExp(samplePoints, scalars[k], ecc.MultiExpConfig{})
+			}
+		}
+	})
+	b.Run("batch", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			MultiExpBatchG1(samplePoints, scalars, ecc.MultiExpConfig{})
+		}
+	})
+}
+
+func BenchmarkMultiExpSparseG1(b *testing.B) {
+	const nbSamples = 1 << 16
+
+	samplePoints := make([]G1Affine, nbSamples)
+	fillBenchBasesG1(samplePoints)
+	// 45% of zeros, 45% of ones
+	sampleScalars := make([]fr.Element, nbSamples)
+	fillBenchScalars(sampleScalars)
+	for i := range sampleScalars {
+		switch i % 20 {
+		case 0, 1:
+		case 2, 3, 4, 5, 6, 7, 8, 9, 10:
+			sampleScalars[i].SetZero()
+		default:
+			sampleScalars[i].SetOne()
+		}
+	}
+
+	var r G1Jac
+	b.Run("default", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			r.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{})
+		}
+	})
+	b.Run("sparse", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			r.MultiExp(samplePoints, sampleScalars, ecc.MultiExpConfig{SparseOptimization: true})
+		}
+	})
+}
+
 func BenchmarkMultiExpG1Reference(b *testing.B) {
 	const nbSamples = 1 << 20
 
@@ -85,6 +85,44 @@ func WnafDecomposition(a *big.Int, window uint, result []int8) int {
 	return length
 }
 
+// NAF returns the non-adjacent form of k, least significant digit first.
+// Digits are in {-1, 0, 1} and no two consecutive digits are non-zero.
+// If k is negative, the digits of -k are negated.
+func NAF(k *big.Int) []int8 {
+	var abs big.Int
+	abs.Abs(k)
+	res := make([]int8, abs.BitLen()+1)
+	res = res[:NafDecomposition(&abs, res)]
+	if k.Sign() < 0 {
+		negateDigits(res)
+	}
+	return res
+}
+
+// WNAF returns the width-w non-adjacent form of k, least significant digit
+// first. Non-zero digits are odd, lie in (-2ʷ⁻¹, 2ʷ⁻¹), and any w consecutive
+// digits contain at most one non-zero digit. If k is negative, the digits of -k
+// are negated. It returns nil if w is not in [2, 8].
+func WNAF(k *big.Int, w int) []int8 {
+	if w < 2 || w > 8 {
+		return nil
+	}
+	var abs big.Int
+	abs.Abs(k)
+	res := make([]int8, abs.BitLen()+1)
+	res = res[:WnafDecomposition(&abs, uint(w), res)]
+	if k.Sign() < 0 {
+		negateDigits(res)
+	}
+	return res
+}
+
+func negateDigits(digits []int8) {
+	for i := range digits {
+		digits[i] = -digits[i]
+	}
+}
+
 //-------------------------------------------------------
 // GLV utils
 
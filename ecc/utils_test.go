@@ -1,6 +1,7 @@
 package ecc
 
 import (
+	"crypto/rand"
 	"math/big"
 	"testing"
 )
@@ -75,6 +76,88 @@ func TestNafDecomposition(t *testing.T) {
 	}
 }
 
+// fromDigits returns Σ digits[i]·2ⁱ.
+func fromDigits(digits []int8) *big.Int {
+	res := new(big.Int)
+	for i := len(digits) - 1; i >= 0; i-- {
+		res.Lsh(res, 1)
+		res.Add(res, big.NewInt(int64(digits[i])))
+	}
+	return res
+}
+
+func TestNAF(t *testing.T) {
+	t.Parallel()
+
+	bound := new(big.Int).Lsh(big.NewInt(1), 256)
+	for i := 0; i < 100; i++ {
+		k, err := rand.Int(rand.Reader, bound)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i%2 == 1 {
+			k.Neg(k)
+		}
+		naf := NAF(k)
+		if fromDigits(naf).Cmp(k) != 0 {
+			t.Fatalf("NAF reconstruction failed for %s", k)
+		}
+		for j := range naf {
+			if naf[j] < -1 || naf[j] > 1 {
+				t.Fatalf("invalid NAF digit %d", naf[j])
+			}
+			if j > 0 && naf[j] != 0 && naf[j-1] != 0 {
+				t.Fatal("adjacent non-zero NAF digits")
+			}
+		}
+	}
+
+	if len(NAF(new(big.Int))) != 0 {
+		t.Fatal("NAF of zero should be empty")
+	}
+}
+
+func TestWNAF(t *testing.T) {
+	t.Parallel()
+
+	bound := new(big.Int).Lsh(big.NewInt(1), 256)
+	for w := 2; w <= 8; w++ {
+		for i := 0; i < 50; i++ {
+			k, err := rand.Int(rand.Reader, bound)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if i%2 == 1 {
+				k.Neg(k)
+			}
+			wnaf := WNAF(k, w)
+			if fromDigits(wnaf).Cmp(k) != 0 {
+				t.Fatalf("wNAF reconstruction failed for %s, w=%d", k, w)
+			}
+			last := -w
+			for j, d := range wnaf {
+				if d == 0 {
+					continue
+				}
+				if d%2 == 0 {
+					t.Fatalf("wNAF digit %d should be odd", d)
+				}
+				if int(d) >= 1<<(w-1) || int(d) <= -(1<<(w-1)) {
+					t.Fatalf("wNAF digit %d out of bounds for w=%d", d, w)
+				}
+				if j-last < w {
+					t.Fatalf("non-zero wNAF digits too close for w=%d", w)
+				}
+				last = j
+			}
+		}
+	}
+
+	if WNAF(big.NewInt(5), 1) != nil || WNAF(big.NewInt(5), 9) != nil {
+		t.Fatal("WNAF should reject invalid window sizes")
+	}
+}
+
 func TestSplitting(t *testing.T) {
 	t.Parallel()
 
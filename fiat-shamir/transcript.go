@@ -71,6 +71,26 @@ func (t *Transcript) Bind(challengeID string, bValue []byte) error {
 
 }
 
+// Marshaler is a value with a canonical encoding: Marshal must return the same
+// bytes for equal values, and different bytes for different values.
+//
+// It is implemented by the field elements and the curve points of gnark-crypto
+// (e.g. *fr.Element, *bls12377.G1Affine), Marshal returning their encoding
+// big-endian in regular form for field elements and uncompressed for points.
+type Marshaler interface {
+	Marshal() []byte
+}
+
+// BindElement binds the challenge to the canonical encoding of e, such that
+// every caller hashes the same bytes for the same element. It is equivalent to
+// Bind(challengeID, e.Marshal()).
+//
+// The curve packages provide typed binds with the same encoding (e.g.
+// bls12377.BindFr and bls12377.BindG1).
+func (t *Transcript) BindElement(challengeID string, e Marshaler) error {
+	return t.Bind(challengeID, e.Marshal())
+}
+
 // ComputeChallenge computes the challenge corresponding to the given name.
 // The challenge is:
 // * H(name || previous_challenge || binded_values...) if the challenge is not the first one
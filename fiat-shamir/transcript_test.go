@@ -128,3 +128,39 @@ func TestBindToComputedChallenge(t *testing.T) {
 	}
 
 }
+
+// canonical is a Marshaler with a fixed encoding.
+type canonical []byte
+
+func (c canonical) Marshal() []byte {
+	return c
+}
+
+func TestBindElement(t *testing.T) {
+	t.Parallel()
+
+	values := []canonical{[]byte("v1"), []byte("v2")}
+
+	typed := NewTranscript(sha256.New(), "alpha")
+	generic := NewTranscript(sha256.New(), "alpha")
+	for _, v := range values {
+		if err := typed.BindElement("alpha", v); err != nil {
+			t.Fatal(err)
+		}
+		if err := generic.Bind("alpha", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	typedChallenge, err := typed.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	genericChallenge, err := generic.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(typedChallenge, genericChallenge) {
+		t.Fatal("binding an element should be the same as binding its canonical bytes")
+	}
+}
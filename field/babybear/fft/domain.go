@@ -37,7 +37,9 @@ type Domain struct {
 	// if false, the FFT will compute the twiddles on the fly (this is less CPU efficient, but uses less memory)
 	withPrecompute bool
 
-	// the following slices are not serialized and are (re)computed through domain.preComputeTwiddles()
+	// the following slices are (re)computed through domain.preComputeTwiddles();
+	// WriteTo/ReadFrom do not serialize them, WriteRawTo/UnsafeReadFrom store the first
+	// stage of twiddles and twiddlesInv, and the coset tables, in Montgomery form.
 
 	// twiddles factor for the FFT using Generator for each stage of the recursive FFT
 	twiddles [][]babybear.Element
@@ -243,6 +245,48 @@ func (d *Domain) CosetTableInv() ([]babybear.Element, error) {
 	return d.cosetTableInv, nil
 }
 
+// VanishingPolynomial returns the coefficients of Z = Xⁿ - 1, the polynomial
+// vanishing on the domain, n being the cardinality.
+func (d *Domain) VanishingPolynomial() []babybear.Element {
+	res := make([]babybear.Element, d.Cardinality+1)
+	res[0].SetOne().Neg(&res[0])
+	res[d.Cardinality].SetOne()
+	return res
+}
+
+// EvaluateVanishing returns Z(x) = xⁿ - 1, n being the cardinality.
+func (d *Domain) EvaluateVanishing(x babybear.Element) babybear.Element {
+	z, _ := d.evaluateVanishing(x)
+	return z
+}
+
+// EvaluateVanishingDerivative returns Z'(x) = n·xⁿ⁻¹, n being the cardinality.
+//
+// On the domain, Z'(ωⁱ) = n·ω⁻ⁱ = ∏_{j≠i}(ωⁱ - ωʲ) is non zero: it is the inverse
+// of the i-th barycentric weight, while Z(ωⁱ) = 0.
+func (d *Domain) EvaluateVanishingDerivative(x babybear.Element) babybear.Element {
+	_, dz := d.evaluateVanishing(x)
+	return dz
+}
+
+// evaluateVanishing returns Z(x) and Z'(x), computing xⁿ⁻¹ and xⁿ with
+// log₂(n) squarings since n is a power of two.
+func (d *Domain) evaluateVanishing(x babybear.Element) (z, dz babybear.Element) {
+	// dz = x^(2⁰+2¹+...+2ᵏ⁻¹) = xⁿ⁻¹, z = x^(2ᵏ) = xⁿ
+	z.Set(&x)
+	dz.SetOne()
+	for i := d.Cardinality; i > 1; i >>= 1 {
+		dz.Mul(&dz, &z)
+		z.Square(&z)
+	}
+	var n, one babybear.Element
+	n.SetUint64(d.Cardinality)
+	one.SetOne()
+	z.Sub(&z, &one)
+	dz.Mul(&dz, &n)
+	return
+}
+
 func (d *Domain) preComputeTwiddles() {
 
 	// nb fft stages
@@ -392,6 +436,21 @@ func (d *Domain) WriteTo(w io.Writer) (int64, error) {
 
 // ReadFrom attempts to decode a domain from Reader
 func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
+	read, err := d.readHeader(r)
+	if err != nil {
+		return read, err
+	}
+
+	if d.withPrecompute {
+		d.preComputeTwiddles()
+	}
+
+	return read, nil
+}
+
+// readHeader decodes the domain parameters written by WriteTo, without
+// computing the twiddle factors.
+func (d *Domain) readHeader(r io.Reader) (int64, error) {
 
 	var read int64
 	var err error
@@ -406,7 +465,7 @@ func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
 
 	for _, v := range toDecode {
 		var buf [babybear.Bytes]byte
-		_, err = r.Read(buf[:])
+		_, err = io.ReadFull(r, buf[:])
 		if err != nil {
 			return read, err
 		}
@@ -423,13 +482,200 @@ func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
 	}
 	read += 1
 
-	if d.withPrecompute {
-		d.preComputeTwiddles()
+	return read, nil
+}
+
+// WriteRawTo writes a binary representation of the domain, including the
+// precomputed twiddle factors and coset tables, to the provided writer.
+//
+// The output starts with the encoding of WriteTo, followed by the tables in
+// Montgomery form, so that UnsafeReadFrom loads them without any field
+// arithmetic.
+func (d *Domain) WriteRawTo(w io.Writer) (int64, error) {
+	written, err := d.WriteTo(w)
+	if err != nil || !d.withPrecompute {
+		return written, err
+	}
+
+	// the twiddles of the next stages are strided copies of the first stage.
+	var firstTwiddles, firstTwiddlesInv []babybear.Element
+	if len(d.twiddles) > 0 {
+		firstTwiddles, firstTwiddlesInv = d.twiddles[0], d.twiddlesInv[0]
+	}
+	for _, t := range [][]babybear.Element{firstTwiddles, firstTwiddlesInv, d.cosetTable, d.cosetTableInv} {
+		n, err := writeMontgomeryTable(w, t)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// UnsafeReadFrom decodes a domain written with WriteRawTo.
+//
+// The domain parameters are checked against the field: the generator must be
+// the one returned by Generator for the domain cardinality, and the inverses
+// must be consistent. The precomputed tables are only checked for their size
+// and first entries, so the input must come from a trusted source.
+func (d *Domain) UnsafeReadFrom(r io.Reader) (int64, error) {
+	var header Domain
+	read, err := header.readHeader(r)
+	if err != nil {
+		return read, err
+	}
+	if err := header.checkParameters(); err != nil {
+		return read, err
 	}
 
+	if header.withPrecompute {
+		nbStages := uint64(bits.TrailingZeros64(header.Cardinality))
+		twiddlesLen := 0
+		if nbStages > 0 {
+			twiddlesLen = 1 + (1 << (nbStages - 1))
+		}
+		firstTwiddles := make([]babybear.Element, twiddlesLen)
+		firstTwiddlesInv := make([]babybear.Element, twiddlesLen)
+		cosetTable := make([]babybear.Element, header.Cardinality)
+		cosetTableInv := make([]babybear.Element, header.Cardinality)
+		for _, t := range [][]babybear.Element{firstTwiddles, firstTwiddlesInv, cosetTable, cosetTableInv} {
+			n, err := readMontgomeryTable(r, t)
+			read += n
+			if err != nil {
+				return read, err
+			}
+		}
+		if !checkExpTable(firstTwiddles, header.Generator) ||
+			!checkExpTable(firstTwiddlesInv, header.GeneratorInv) ||
+			!checkExpTable(cosetTable, header.FrMultiplicativeGen) ||
+			!checkExpTable(cosetTableInv, header.FrMultiplicativeGenInv) {
+			return read, errors.New("invalid precomputed table")
+		}
+
+		header.twiddles = make([][]babybear.Element, nbStages)
+		header.twiddlesInv = make([][]babybear.Element, nbStages)
+		if nbStages > 0 {
+			header.twiddles[0] = firstTwiddles
+			header.twiddlesInv[0] = firstTwiddlesInv
+			for i := uint64(1); i < nbStages; i++ {
+				header.twiddles[i] = make([]babybear.Element, 1+(1<<(nbStages-i-1)))
+				header.twiddlesInv[i] = make([]babybear.Element, 1+(1<<(nbStages-i-1)))
+				for j := range header.twiddles[i] {
+					header.twiddles[i][j] = firstTwiddles[j<<i]
+					header.twiddlesInv[i][j] = firstTwiddlesInv[j<<i]
+				}
+			}
+		}
+		header.cosetTable = cosetTable
+		header.cosetTableInv = cosetTableInv
+		if header.Cardinality <= 1<<18 {
+			header.cosetTableBitReversed = make([]babybear.Element, header.Cardinality)
+			copy(header.cosetTableBitReversed, header.cosetTable)
+			utils.BitReverse(header.cosetTableBitReversed)
+		}
+	}
+
+	*d = header
 	return read, nil
 }
 
+// montgomeryChunkSize is the number of elements buffered at once when
+// encoding or decoding precomputed tables.
+const montgomeryChunkSize = 1 << 12
+
+// writeMontgomeryTable writes the length of t as a big-endian uint64, followed
+// by the little-endian limbs of each element in Montgomery form.
+func writeMontgomeryTable(w io.Writer, t []babybear.Element) (int64, error) {
+	if err := binary.Write(w, binary.BigEndian, uint64(len(t))); err != nil {
+		return 0, err
+	}
+	written := int64(8)
+
+	buf := make([]byte, min(len(t), montgomeryChunkSize)*babybear.Bytes)
+	for start := 0; start < len(t); start += montgomeryChunkSize {
+		chunk := t[start:min(start+montgomeryChunkSize, len(t))]
+		offset := 0
+		for i := range chunk {
+			for j := range chunk[i] {
+				binary.LittleEndian.PutUint32(buf[offset:], chunk[i][j])
+				offset += 4
+			}
+		}
+		n, err := w.Write(buf[:offset])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// readMontgomeryTable reads a table written by writeMontgomeryTable into t.
+// It returns an error if the encoded length does not match len(t).
+func readMontgomeryTable(r io.Reader, t []babybear.Element) (int64, error) {
+	var length uint64
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return 0, err
+	}
+	read := int64(8)
+	if length != uint64(len(t)) {
+		return read, errors.New("invalid precomputed table size")
+	}
+
+	buf := make([]byte, min(len(t), montgomeryChunkSize)*babybear.Bytes)
+	for start := 0; start < len(t); start += montgomeryChunkSize {
+		chunk := t[start:min(start+montgomeryChunkSize, len(t))]
+		n, err := io.ReadFull(r, buf[:len(chunk)*babybear.Bytes])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		offset := 0
+		for i := range chunk {
+			for j := range chunk[i] {
+				chunk[i][j] = binary.LittleEndian.Uint32(buf[offset:])
+				offset += 4
+			}
+		}
+	}
+	return read, nil
+}
+
+// checkParameters returns an error if the domain parameters are not
+// consistent with the field.
+func (d *Domain) checkParameters() error {
+	if d.Cardinality == 0 || d.Cardinality&(d.Cardinality-1) != 0 {
+		return errors.New("domain cardinality is not a power of 2")
+	}
+	generator, err := Generator(d.Cardinality)
+	if err != nil {
+		return err
+	}
+	if !d.Generator.Equal(&generator) {
+		return errors.New("domain generator does not match the field")
+	}
+	var one, t babybear.Element
+	one.SetOne()
+	if !t.Mul(&d.Generator, &d.GeneratorInv).Equal(&one) ||
+		!t.SetUint64(d.Cardinality).Mul(&t, &d.CardinalityInv).Equal(&one) ||
+		!t.Mul(&d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv).Equal(&one) {
+		return errors.New("inconsistent domain parameters")
+	}
+	return nil
+}
+
+// checkExpTable checks the first entries of a table of powers of w.
+func checkExpTable(table []babybear.Element, w babybear.Element) bool {
+	if len(table) == 0 {
+		return true
+	}
+	if !table[0].IsOne() {
+		return false
+	}
+	return len(table) == 1 || table[1].Equal(&w)
+}
+
 // BitReverse applies the bit-reversal permutation to v.
 //
 // The length of v must be a power of 2.
@@ -6,6 +6,7 @@
 package fft
 
 import (
+	"errors"
 	"math/big"
 	"math/bits"
 
@@ -199,6 +200,88 @@ func (domain *Domain) FFTInverse(a []babybear.Element, decimation Decimation, op
 
 }
 
+// BatchFFT computes the discrete Fourier transform of each of the polys and
+// stores the results in place, as if [Domain.FFT] was called sequentially on
+// each of them with the same decimation and options.
+//
+// The transforms are distributed across at most nbTasks go routines (see
+// [WithNbTasks]); the domain's precomputed tables are only read, so they are
+// shared between the go routines. All the polys must have the same size.
+func (domain *Domain) BatchFFT(polys [][]babybear.Element, decimation Decimation, opts ...Option) {
+	if len(polys) == 0 {
+		return
+	}
+	for i := 1; i < len(polys); i++ {
+		if len(polys[i]) != len(polys[0]) {
+			panic("all polynomials must have the same size")
+		}
+	}
+	opt := fftOptions(opts)
+
+	// if there are fewer polys than tasks, each transform is itself parallelized
+	nbTasksPerFFT := opt.nbTasks / len(polys)
+	if nbTasksPerFFT < 1 {
+		nbTasksPerFFT = 1
+	}
+	fftOpts := make([]Option, 0, 2)
+	fftOpts = append(fftOpts, WithNbTasks(nbTasksPerFFT))
+	if opt.coset {
+		fftOpts = append(fftOpts, OnCoset())
+	}
+
+	parallel.Execute(len(polys), func(start, end int) {
+		for i := start; i < end; i++ {
+			domain.FFT(polys[i], decimation, fftOpts...)
+		}
+	}, opt.nbTasks)
+}
+
+// QuotientOnCoset returns the polynomial, in canonical form, interpolating
+// numerator/denominator on the coset FrMultiplicativeGen*<Generator>.
+//
+// numerator and denominator are given in canonical form, with at most
+// domain.Cardinality coefficients. Both are evaluated on the coset, divided
+// pointwise (using a single inversion), and the result is interpolated back.
+// When denominator divides numerator and the quotient has fewer than
+// domain.Cardinality coefficients, the result is the exact quotient.
+//
+// It returns an error if the denominator vanishes on one of the coset points.
+// numerator and denominator are not modified.
+func (domain *Domain) QuotientOnCoset(numerator, denominator []babybear.Element) ([]babybear.Element, error) {
+	n := int(domain.Cardinality)
+	if len(numerator) > n || len(denominator) > n {
+		return nil, errors.New("polynomial size exceeds the domain cardinality")
+	}
+
+	res := make([]babybear.Element, n)
+	scratch := make([]babybear.Element, n)
+	copy(res, numerator)
+	copy(scratch, denominator)
+
+	domain.FFT(res, DIF, OnCoset())
+	domain.FFT(scratch, DIF, OnCoset())
+
+	// res[i] <- numerator[i] * (denominator[0]*...*denominator[i-1])
+	var acc babybear.Element
+	acc.SetOne()
+	for i := 0; i < n; i++ {
+		if scratch[i].IsZero() {
+			return nil, errors.New("denominator vanishes on the coset")
+		}
+		res[i].Mul(&res[i], &acc)
+		acc.Mul(&acc, &scratch[i])
+	}
+	// walk backwards with acc = (denominator[0]*...*denominator[i])^-1
+	acc.Inverse(&acc)
+	for i := n - 1; i >= 0; i-- {
+		res[i].Mul(&res[i], &acc)
+		acc.Mul(&acc, &scratch[i])
+	}
+
+	domain.FFTInverse(res, DIT, OnCoset())
+	return res, nil
+}
+
 func difFFT(a []babybear.Element, w babybear.Element, twiddles [][]babybear.Element, twiddlesStartStage, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
 	if chDone != nil {
 		defer close(chDone)
@@ -0,0 +1,60 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package field defines the interface implemented by the field elements of this
+// module (fr.Element and fp.Element of each curve, babybear.Element, ...), so
+// that algorithms can be written once for all of them with generics.
+//
+// The generated element types satisfy the interface as is, without wrapper:
+//
+//	func Sum[E any, PE field.PtrElement[E]](v []E) E {
+//		var res E
+//		for i := range v {
+//			PE(&res).Add(&res, &v[i])
+//		}
+//		return res
+//	}
+//
+// Calls through the type parameters may not be inlined, so generic code can be
+// slower than the same code written for a concrete type: performance critical
+// paths should keep using the concrete types.
+package field
+
+// Element is the set of methods shared by the field elements of this module,
+// *E being the element type: *fr.Element implements Element[fr.Element].
+type Element[E any] interface {
+	Set(x *E) *E
+	SetZero() *E
+	SetOne() *E
+	SetUint64(v uint64) *E
+	Add(x, y *E) *E
+	Sub(x, y *E) *E
+	Neg(x *E) *E
+	Double(x *E) *E
+	Mul(x, y *E) *E
+	Square(x *E) *E
+	// Inverse sets z to x⁻¹, or to 0 if x = 0.
+	Inverse(x *E) *E
+	Equal(x *E) bool
+	IsZero() bool
+	IsOne() bool
+	String() string
+}
+
+// PtrElement is the constraint to use in generic code: PE is *E, and E is a
+// value type which can be stored in slices, zeroed and copied.
+type PtrElement[E any] interface {
+	*E
+	Element[E]
+}
+
+// Horner evaluates the polynomial ∑ᵢ coefficients[i]⋅xⁱ at x with Horner's
+// method. The coefficients are in increasing order of degree.
+func Horner[E any, PE PtrElement[E]](coefficients []E, x *E) E {
+	var res E
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		PE(&res).Mul(&res, x)
+		PE(&res).Add(&res, &coefficients[i])
+	}
+	return res
+}
@@ -0,0 +1,109 @@
+// Copyright 2020-2026 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package field_test
+
+import (
+	"testing"
+
+	fr377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	poly377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/polynomial"
+	fr381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	poly381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	fp254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/field"
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ field.Element[fr377.Element]      = (*fr377.Element)(nil)
+	_ field.Element[fr381.Element]      = (*fr381.Element)(nil)
+	_ field.Element[fp254.Element]      = (*fp254.Element)(nil)
+	_ field.Element[babybear.Element]   = (*babybear.Element)(nil)
+	_ field.Element[goldilocks.Element] = (*goldilocks.Element)(nil)
+)
+
+// naiveEval returns ∑ᵢ coefficients[i]⋅xⁱ, computing the powers of x.
+func naiveEval[E any, PE field.PtrElement[E]](coefficients []E, x *E) E {
+	var res, xi, t E
+	PE(&xi).SetOne()
+	for i := range coefficients {
+		PE(&t).Mul(&coefficients[i], &xi)
+		PE(&res).Add(&res, &t)
+		PE(&xi).Mul(&xi, x)
+	}
+	return res
+}
+
+// testHorner checks Horner against naiveEval and eval, an evaluation written
+// for the concrete type.
+func testHorner[E any, PE field.PtrElement[E]](t *testing.T, random func() E, eval func([]E, *E) E) {
+	assert := require.New(t)
+
+	for _, n := range []int{0, 1, 2, 17} {
+		coefficients := make([]E, n)
+		for i := range coefficients {
+			coefficients[i] = random()
+		}
+		x := random()
+
+		res := field.Horner[E, PE](coefficients, &x)
+		expected := naiveEval[E, PE](coefficients, &x)
+		assert.True(PE(&res).Equal(&expected), "Horner and the naive evaluation differ for %d coefficients", n)
+		if n == 0 {
+			assert.True(PE(&res).IsZero())
+			continue
+		}
+		expected = eval(coefficients, &x)
+		assert.True(PE(&res).Equal(&expected), "Horner and the concrete evaluation differ for %d coefficients", n)
+	}
+
+	// 1 + 2X + 3X² at X = -1 is 2
+	var one, minusOne, two, three E
+	PE(&one).SetOne()
+	PE(&minusOne).Neg(&one)
+	PE(&two).SetUint64(2)
+	PE(&three).SetUint64(3)
+	res := field.Horner[E, PE]([]E{one, two, three}, &minusOne)
+	assert.True(PE(&res).Equal(&two), "1 + 2X + 3X² at -1 = %s", PE(&res).String())
+}
+
+func TestHorner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bls12-377", func(t *testing.T) {
+		testHorner(t,
+			func() (e fr377.Element) { return *e.MustSetRandom() },
+			func(c []fr377.Element, x *fr377.Element) fr377.Element { return (*poly377.Polynomial)(&c).Eval(x) },
+		)
+	})
+	t.Run("bls12-381", func(t *testing.T) {
+		testHorner(t,
+			func() (e fr381.Element) { return *e.MustSetRandom() },
+			func(c []fr381.Element, x *fr381.Element) fr381.Element { return (*poly381.Polynomial)(&c).Eval(x) },
+		)
+	})
+}
+
+func BenchmarkHorner(b *testing.B) {
+	coefficients := make([]fr377.Element, 1<<10)
+	for i := range coefficients {
+		coefficients[i].MustSetRandom()
+	}
+	var x fr377.Element
+	x.MustSetRandom()
+	p := poly377.Polynomial(coefficients)
+
+	b.Run("generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			field.Horner(coefficients, &x)
+		}
+	})
+	b.Run("concrete", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p.Eval(&x)
+		}
+	})
+}
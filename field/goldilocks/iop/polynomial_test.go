@@ -108,6 +108,39 @@ func TestEvaluation(t *testing.T) {
 
 }
 
+func TestBlind(t *testing.T) {
+
+	size := 8
+	blindingDegree := 2
+	d := fft.NewDomain(uint64(size))
+	p := NewPolynomial(randomVector(size), Form{Basis: Canonical, Layout: Regular})
+	blinded := p.Clone().Blind(d, blindingDegree)
+
+	if len(blinded.Coefficients()) != size+blindingDegree+1 {
+		t.Fatal("wrong number of coefficients after blinding")
+	}
+
+	// the blinded polynomial should agree with p on the domain
+	var x goldilocks.Element
+	x.SetOne()
+	for i := 0; i < size; i++ {
+		a := p.Evaluate(x)
+		b := blinded.Evaluate(x)
+		if !a.Equal(&b) {
+			t.Fatal("blinded polynomial should agree with the original on the domain")
+		}
+		x.Mul(&x, &d.Generator)
+	}
+
+	// but differ elsewhere
+	x.MustSetRandom()
+	a := p.Evaluate(x)
+	b := blinded.Evaluate(x)
+	if a.Equal(&b) {
+		t.Fatal("blinded polynomial should differ from the original outside the domain")
+	}
+}
+
 func randomVector(size int) *[]goldilocks.Element {
 	r := make([]goldilocks.Element, size)
 	goldilocks.Vector(r).MustSetRandom()
@@ -1,14 +1,29 @@
 package hash
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"errors"
+	"io"
 )
 
+// oversizeDSTPrefix is prepended to domain separation tags longer than 255 bytes
+// before hashing them down, see [ExpandMsgXmd].
+const oversizeDSTPrefix = "H2C-OVERSIZE-DST-"
+
 // ExpandMsgXmd expands msg to a slice of lenInBytes bytes.
+// Domain separation tags longer than 255 bytes are replaced by
+// H("H2C-OVERSIZE-DST-" ∥ dst), as specified in RFC 9380 section 5.3.3.
 // https://datatracker.ietf.org/doc/html/rfc9380#name-expand_message_xmd
 // https://datatracker.ietf.org/doc/html/rfc9380#name-utility-functions (I2OSP/O2ISP)
 func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	return ExpandMsgXmdReader(bytes.NewReader(msg), dst, lenInBytes)
+}
+
+// ExpandMsgXmdReader is [ExpandMsgXmd] for a message read from r until io.EOF.
+// The message is streamed through the hash function and never held in memory,
+// so that arbitrarily large messages can be expanded.
+func ExpandMsgXmdReader(r io.Reader, dst []byte, lenInBytes int) ([]byte, error) {
 
 	h := sha256.New()
 	ell := (lenInBytes + h.Size() - 1) / h.Size() // ceil(len_in_bytes / b_in_bytes)
@@ -16,7 +31,14 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 		return nil, errors.New("invalid lenInBytes")
 	}
 	if len(dst) > 255 {
-		return nil, errors.New("invalid domain size (>255 bytes)")
+		// DST = H("H2C-OVERSIZE-DST-" ∥ a_very_long_DST)
+		if _, err := h.Write([]byte(oversizeDSTPrefix)); err != nil {
+			return nil, err
+		}
+		if _, err := h.Write(dst); err != nil {
+			return nil, err
+		}
+		dst = h.Sum(nil)
 	}
 	sizeDomain := uint8(len(dst))
 
@@ -28,7 +50,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	if _, err := h.Write(make([]byte, h.BlockSize())); err != nil {
 		return nil, err
 	}
-	if _, err := h.Write(msg); err != nil {
+	if _, err := io.Copy(h, r); err != nil {
 		return nil, err
 	}
 	if _, err := h.Write([]byte{uint8(lenInBytes >> 8), uint8(lenInBytes), uint8(0)}); err != nil {
@@ -59,7 +81,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	b1 := h.Sum(nil)
 
 	res := make([]byte, lenInBytes)
-	copy(res[:h.Size()], b1)
+	copy(res, b1)
 
 	for i := 2; i <= ell; i++ {
 		// b_i = H(strxor(b₀, b_(i - 1)) ∥ I2OSP(i, 1) ∥ DST_prime)
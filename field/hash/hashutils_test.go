@@ -3,7 +3,10 @@ package hash
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 type expandMsgXmdTestCase struct {
@@ -105,6 +108,19 @@ func TestExpandMsgXmd(t *testing.T) {
 			0x30,
 			"1aaee90016547a85ab4dc55e4f78a364c2e239c0e58b05753453c63e6e818334005e90d9ce8f047bddab9fbb315f8722",
 		},
+		// outputs shorter than a SHA-256 digest, as used for small fields
+		// (computed following RFC 9380, section 5.3.1)
+		{
+			"",
+			0x14,
+			"de30afeca160bfd544479ac0750ccc4166515643",
+		},
+
+		{
+			"abc",
+			0x14,
+			"c9c9c73ca9bc779cf93a9aa5c9c8560c6e66722d",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -130,3 +146,54 @@ func TestExpandMsgXmd(t *testing.T) {
 		}
 	}
 }
+
+// Test vectors from RFC 9380, Appendix K.1, with a DST longer than 255 bytes.
+func TestExpandMsgXmdLongDST(t *testing.T) {
+	dst := "QUUX-V01-CS02-with-expander-SHA256-128-long-DST-" + strings.Repeat("1", 208)
+
+	testCases := []expandMsgXmdTestCase{
+		{
+			"",
+			0x20,
+			"e8dc0c8b686b7ef2074086fbdd2f30e3f8bfbd3bdf177f73f04b97ce618a3ed3",
+		},
+		{
+			"abc",
+			0x20,
+			"52dbf4f36cf560fca57dedec2ad924ee9c266341d8f3d6afe5171733b16bbb12",
+		},
+	}
+
+	for _, testCase := range testCases {
+		uniformBytes, err := ExpandMsgXmd([]byte(testCase.msg), []byte(dst), testCase.lenInBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(uniformBytes) != testCase.uniformBytesHex {
+			t.Errorf("expected \"%s\" got \"%x\"", testCase.uniformBytesHex, uniformBytes)
+		}
+	}
+}
+
+func TestExpandMsgXmdReader(t *testing.T) {
+	msg := bytes.Repeat([]byte("gnark-crypto"), 100000)
+	for _, dst := range [][]byte{[]byte("QUUX-V01-CS02-with-expander-SHA256-128"), bytes.Repeat([]byte{0xab}, 1000)} {
+		expected, err := ExpandMsgXmd(msg, dst, 0x80)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the message is read in small chunks
+		uniformBytes, err := ExpandMsgXmdReader(iotest.HalfReader(bytes.NewReader(msg)), dst, 0x80)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(uniformBytes, expected) {
+			t.Errorf("streamed expansion differs: expected \"%x\" got \"%x\"", expected, uniformBytes)
+		}
+	}
+
+	// read errors are reported
+	if _, err := ExpandMsgXmdReader(iotest.ErrReader(errors.New("read error")), nil, 0x20); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -39,6 +39,143 @@ func TestDomainSerialization(t *testing.T) {
 	}
 }
 
+func TestDomainRawSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	var shift koalabear.Element
+	shift.SetUint64(7)
+	domains := []*Domain{
+		NewDomain(1 << 6),
+		NewDomain(1<<6, WithShift(shift)),
+		NewDomain(1<<6, WithoutPrecompute()),
+		NewDomain(1),
+	}
+	for _, domain := range domains {
+		var buf bytes.Buffer
+		written, err := domain.WriteRawTo(&buf)
+		assert.NoError(err)
+
+		var reconstructed Domain
+		read, err := reconstructed.UnsafeReadFrom(&buf)
+		assert.NoError(err)
+		assert.Equal(written, read, "didn't read as many bytes as we wrote")
+		assert.True(reflect.DeepEqual(domain, &reconstructed), "Domain.UnsafeReadFrom(WriteRawTo()) failed")
+	}
+
+	// the raw encoding starts with the regular one
+	domain := NewDomain(1 << 6)
+	var buf bytes.Buffer
+	_, err := domain.WriteRawTo(&buf)
+	assert.NoError(err)
+	var reconstructed Domain
+	_, err = reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.True(reflect.DeepEqual(domain, &reconstructed))
+
+	// tampered generator
+	tampered := *domain
+	tampered.Generator.SetUint64(2)
+	buf.Reset()
+	_, err = tampered.WriteRawTo(&buf)
+	assert.NoError(err)
+	_, err = reconstructed.UnsafeReadFrom(&buf)
+	assert.Error(err)
+
+	// tampered table
+	buf.Reset()
+	_, err = domain.WriteRawTo(&buf)
+	assert.NoError(err)
+	data := buf.Bytes()
+	data[len(data)-1] ^= 1
+	_, err = reconstructed.UnsafeReadFrom(bytes.NewReader(data))
+	assert.NoError(err, "only the first entries of the tables are checked")
+	data = data[:len(data)-1]
+	_, err = reconstructed.UnsafeReadFrom(bytes.NewReader(data))
+	assert.Error(err, "truncated input should be rejected")
+}
+
+func TestRootOfUnity(t *testing.T) {
+	assert := require.New(t)
+
+	var one, minusOne koalabear.Element
+	one.SetOne()
+	minusOne.Neg(&one)
+
+	maxOrder := koalabear.TwoAdicity()
+	for k := uint64(0); k <= maxOrder; k++ {
+		w, err := koalabear.RootOfUnity(1 << k)
+		assert.NoError(err)
+
+		// w has order exactly 2^k iff w^(2^(k-1)) = -1
+		if k == 0 {
+			assert.True(w.Equal(&one), "root of order 1 should be 1")
+			continue
+		}
+		for i := uint64(0); i < k-1; i++ {
+			w.Square(&w)
+		}
+		assert.True(w.Equal(&minusOne), "root of order 2^%d has a smaller order", k)
+	}
+
+	_, err := koalabear.RootOfUnity(0)
+	assert.Error(err)
+	_, err = koalabear.RootOfUnity(6)
+	assert.Error(err)
+	_, err = koalabear.RootOfUnity(1 << (maxOrder + 1))
+	assert.Error(err)
+}
+
+func TestVanishingPolynomial(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []uint64{1, 2, 4, 8, 16} {
+		domain := NewDomain(n)
+		points := make([]koalabear.Element, n)
+		BuildExpTable(domain.Generator, points)
+
+		// Z = ∏ᵢ(X - ωⁱ) and Z' = ∑ᵢ∏_{j≠i}(X - ωʲ)
+		productForm := func(x koalabear.Element) (z, dz koalabear.Element) {
+			z.SetOne()
+			var t koalabear.Element
+			for i := range points {
+				t.Sub(&x, &points[i])
+				dz.Mul(&dz, &t).Add(&dz, &z)
+				z.Mul(&z, &t)
+			}
+			return
+		}
+
+		var x koalabear.Element
+		x.MustSetRandom()
+		for _, x := range append([]koalabear.Element{x, koalabear.NewElement(0)}, points...) {
+			z, dz := productForm(x)
+			gotZ := domain.EvaluateVanishing(x)
+			gotDZ := domain.EvaluateVanishingDerivative(x)
+			assert.True(gotZ.Equal(&z), "Z(x) mismatch for n=%d", n)
+			assert.True(gotDZ.Equal(&dz), "Z'(x) mismatch for n=%d", n)
+
+			// coefficients
+			coeffs := domain.VanishingPolynomial()
+			assert.Equal(int(n+1), len(coeffs))
+			var e koalabear.Element
+			for i := len(coeffs) - 1; i >= 0; i-- {
+				e.Mul(&e, &x).Add(&e, &coeffs[i])
+			}
+			assert.True(e.Equal(&z), "VanishingPolynomial mismatch for n=%d", n)
+		}
+
+		// Z'(ωⁱ) = n·ω⁻ⁱ
+		for i := range points {
+			var expected, inv koalabear.Element
+			expected.SetUint64(n)
+			inv.Inverse(&points[i])
+			expected.Mul(&expected, &inv)
+			got := domain.EvaluateVanishingDerivative(points[i])
+			assert.True(got.Equal(&expected), "Z'(ω^%d) mismatch for n=%d", i, n)
+		}
+	}
+}
+
 func TestNewDomainCache(t *testing.T) {
 	t.Run("CacheWithoutShift", func(t *testing.T) {
 		key1 := domainCacheKey{
@@ -111,6 +248,31 @@ func BenchmarkNewDomainCache(b *testing.B) {
 	})
 }
 
+func BenchmarkDomainRawSerialization(b *testing.B) {
+	const size = 1 << 20
+	domain := NewDomain(size)
+	var buf bytes.Buffer
+	if _, err := domain.WriteRawTo(&buf); err != nil {
+		b.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	b.Run("NewDomain", func(b *testing.B) {
+		for b.Loop() {
+			_ = NewDomain(size)
+		}
+	})
+
+	b.Run("UnsafeReadFrom", func(b *testing.B) {
+		for b.Loop() {
+			var d Domain
+			if _, err := d.UnsafeReadFrom(bytes.NewReader(data)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 // Helper functions
 func getCachedDomain(key domainCacheKey) *Domain {
 	keyMapLock.Lock()
@@ -283,9 +283,147 @@ func FuzzFFT(f *testing.F) {
 	})
 }
 
+func TestBatchFFT(t *testing.T) {
+	assert := require.New(t)
+
+	const (
+		nbPolys = 17
+		size    = 1 << 6
+	)
+	for _, decimation := range []Decimation{DIT, DIF} {
+		for _, coset := range []bool{false, true} {
+			domain := NewDomain(size)
+			var opts []Option
+			if coset {
+				opts = append(opts, OnCoset())
+			}
+
+			polys := make([][]koalabear.Element, nbPolys)
+			expected := make([][]koalabear.Element, nbPolys)
+			for i := range polys {
+				polys[i] = make([]koalabear.Element, size)
+				koalabear.Vector(polys[i]).MustSetRandom()
+				expected[i] = make([]koalabear.Element, size)
+				copy(expected[i], polys[i])
+				domain.FFT(expected[i], decimation, opts...)
+			}
+
+			domain.BatchFFT(polys, decimation, opts...)
+			for i := range polys {
+				assert.Equal(expected[i], polys[i], "decimation %d, coset %v: mismatch for poly %d", decimation, coset, i)
+			}
+		}
+	}
+
+	// without precomputed tables
+	domain := NewDomain(size, WithoutPrecompute())
+	polys := [][]koalabear.Element{make([]koalabear.Element, size), make([]koalabear.Element, size)}
+	koalabear.Vector(polys[0]).MustSetRandom()
+	koalabear.Vector(polys[1]).MustSetRandom()
+	expected := [][]koalabear.Element{make([]koalabear.Element, size), make([]koalabear.Element, size)}
+	copy(expected[0], polys[0])
+	copy(expected[1], polys[1])
+	domain.FFT(expected[0], DIF)
+	domain.FFT(expected[1], DIF)
+	domain.BatchFFT(polys, DIF)
+	assert.Equal(expected, polys)
+
+	assert.Panics(func() {
+		domain.BatchFFT([][]koalabear.Element{make([]koalabear.Element, size), make([]koalabear.Element, size/2)}, DIF)
+	})
+}
+
+func TestQuotientOnCoset(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 1 << 5
+	domain := NewDomain(2 * size)
+
+	// denominator = Xⁿ-1, the vanishing polynomial of the subgroup of size n
+	denominator := make([]koalabear.Element, size+1)
+	denominator[0].SetOne().Neg(&denominator[0])
+	denominator[size].SetOne()
+
+	// numerator = q * denominator
+	q := make([]koalabear.Element, size-1)
+	koalabear.Vector(q).MustSetRandom()
+	numerator := make([]koalabear.Element, 2*size)
+	for i := range q {
+		numerator[i].Sub(&numerator[i], &q[i])
+		numerator[i+size].Add(&numerator[i+size], &q[i])
+	}
+	numeratorCopy := make([]koalabear.Element, len(numerator))
+	copy(numeratorCopy, numerator)
+
+	res, err := domain.QuotientOnCoset(numerator, denominator)
+	assert.NoError(err)
+	assert.Equal(numeratorCopy, numerator, "numerator should not be modified")
+
+	// compare with the three steps done manually
+	num := make([]koalabear.Element, 2*size)
+	den := make([]koalabear.Element, 2*size)
+	copy(num, numerator)
+	copy(den, denominator)
+	domain.FFT(num, DIF, OnCoset())
+	domain.FFT(den, DIF, OnCoset())
+	den = koalabear.BatchInvert(den)
+	for i := range num {
+		num[i].Mul(&num[i], &den[i])
+	}
+	domain.FFTInverse(num, DIT, OnCoset())
+	assert.Equal(num, res)
+
+	// the division is exact
+	for i := range q {
+		assert.True(res[i].Equal(&q[i]), "mismatch at index %d", i)
+	}
+	for i := len(q); i < len(res); i++ {
+		assert.True(res[i].IsZero(), "expected zero at index %d", i)
+	}
+
+	// X - FrMultiplicativeGen vanishes on the first coset point
+	var vanishing [2]koalabear.Element
+	vanishing[0].Neg(&domain.FrMultiplicativeGen)
+	vanishing[1].SetOne()
+	_, err = domain.QuotientOnCoset(numerator, vanishing[:])
+	assert.Error(err)
+
+	// polynomials larger than the domain
+	_, err = domain.QuotientOnCoset(make([]koalabear.Element, 2*size+1), denominator)
+	assert.Error(err)
+}
+
 // --------------------------------------------------------------------
 // benches
 
+func BenchmarkBatchFFT(b *testing.B) {
+	const (
+		nbPolys = 256
+		size    = 1 << 14
+	)
+	domain := NewDomain(size)
+	polys := make([][]koalabear.Element, nbPolys)
+	for i := range polys {
+		polys[i] = make([]koalabear.Element, size)
+		koalabear.Vector(polys[i]).MustSetRandom()
+	}
+
+	b.Run("batch", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			domain.BatchFFT(polys, DIF)
+		}
+	})
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			for i := range polys {
+				domain.FFT(polys[i], DIF)
+			}
+		}
+	})
+}
+
 func BenchmarkFFT(b *testing.B) {
 
 	const maxSize = 1 << 20
@@ -148,6 +148,33 @@ func (p *Polynomial) ShallowClone() *Polynomial {
 	return &res
 }
 
+// Blind blinds p by adding b(X)*Z_H(X), where Z_H(X)=X^{n}-1 is the vanishing
+// polynomial of the domain (n is its cardinality) and b is a random polynomial
+// of degree blindingDegree. The evaluations of p on the domain are preserved.
+//
+// The coefficients of p are grown to n+blindingDegree+1 if needed, the size of
+// p is left unchanged. p is modified in place and returned.
+//
+// /!\ The code panics if p is not in canonical basis, regular layout.
+func (p *Polynomial) Blind(domain *fft.Domain, blindingDegree int) *Polynomial {
+	if p.Form != canonicalRegular {
+		panic("the input must be in canonical basis, regular layout")
+	}
+
+	n := int(domain.Cardinality)
+	p.grow(n + blindingDegree + 1)
+
+	// we add b(X)*(X^{n}-1) to p, where deg(b)=blindingDegree
+	var b koalabear.Element
+	for i := 0; i <= blindingDegree; i++ {
+		b.MustSetRandom()
+		(*p.coefficients)[i].Sub(&(*p.coefficients)[i], &b)
+		(*p.coefficients)[i+n].Add(&(*p.coefficients)[i+n], &b)
+	}
+
+	return p
+}
+
 // GetCoeff returns the i-th entry of p, taking the layout in account.
 func (p *Polynomial) GetCoeff(i int) koalabear.Element {
 
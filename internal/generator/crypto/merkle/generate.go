@@ -0,0 +1,22 @@
+package merkle
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/merkle/template"
+)
+
+func Generate(conf config.Curve, baseDir string, gen *common.Generator) error {
+	conf.Package = "merkle"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "merkle.go"), Templates: []string{"merkle.go.tmpl"}},
+		{File: filepath.Join(baseDir, "merkle_test.go"), Templates: []string{"merkle.test.go.tmpl"}},
+	}
+
+	merkleGen := common.NewDefaultGenerator(template.FS)
+	return merkleGen.Generate(conf, conf.Package, "", "", entries...)
+}
@@ -0,0 +1,8 @@
+package template
+
+import "embed"
+
+// FS contains all templates
+//
+//go:embed *
+var FS embed.FS
@@ -15,6 +15,12 @@ func Generate(conf config.TwistedEdwardsCurve, baseDir string, gen *common.Gener
 		{File: filepath.Join(baseDir, "point_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fixedbase.go"), Templates: []string{"fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fixedbase_test.go"), Templates: []string{"tests/fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "montgomery.go"), Templates: []string{"montgomery.go.tmpl"}},
+		{File: filepath.Join(baseDir, "montgomery_test.go"), Templates: []string{"tests/montgomery.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hash_to_curve.go"), Templates: []string{"hash_to_curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hash_to_curve_test.go"), Templates: []string{"tests/hash_to_curve.go.tmpl"}},
 	}
 
 	edwardsGen := common.NewDefaultGenerator(template.FS)
@@ -0,0 +1,23 @@
+package ipa
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/ipa/template"
+)
+
+func Generate(conf config.Curve, baseDir string, gen *common.Generator) error {
+	// inner product argument polynomial commitment scheme
+	conf.Package = "ipa"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipa.go"), Templates: []string{"ipa.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipa_test.go"), Templates: []string{"ipa.test.go.tmpl"}},
+	}
+	ipaGen := common.NewDefaultGenerator(template.FS)
+	return ipaGen.Generate(conf, conf.Package, "", "", entries...)
+
+}
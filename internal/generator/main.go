@@ -15,6 +15,7 @@ import (
 	configTemplate "github.com/consensys/gnark-crypto/internal/generator/config/template"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/poseidon2"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/merkle"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
@@ -25,6 +26,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/fri"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_curve"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
+	"github.com/consensys/gnark-crypto/internal/generator/ipa"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/mpcsetup"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
@@ -33,6 +35,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
 	"github.com/consensys/gnark-crypto/internal/generator/shplonk"
+	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
 )
 
@@ -158,9 +161,15 @@ func main() {
 			}
 			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, gen))
 
+			// generate sumcheck on fr
+			assertNoError(sumcheck.Generate(conf, filepath.Join(curveDir, "fr", "sumcheck"), gen))
+
 			// generate poseidon2 on fr
 			assertNoError(poseidon2.Generate(conf, filepath.Join(curveDir, "fr", "poseidon2"), gen))
 
+			// generate merkle tree on fr
+			assertNoError(merkle.Generate(conf, filepath.Join(curveDir, "fr", "merkle"), gen))
+
 			fpInfo := fieldConfig.FieldDependency{
 				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fp",
 				FieldPackageName: "fp",
@@ -181,6 +190,9 @@ func main() {
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), gen))
 
+			// generate ipa on fr
+			assertNoError(ipa.Generate(conf, filepath.Join(curveDir, "fr", "ipa"), gen))
+
 			// generate tower of extension
 			assertNoError(tower.Generate(conf, filepath.Join(curveDir, "internal", "fptower"), gen))
 
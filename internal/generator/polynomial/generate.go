@@ -15,6 +15,7 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, g
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "polynomial.go"), Templates: []string{"polynomial.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multilin.go"), Templates: []string{"multilin.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multipoint.go"), Templates: []string{"multipoint.go.tmpl"}},
 		{File: filepath.Join(baseDir, "pool.go"), Templates: []string{"pool.go.tmpl"}},
 	}
 
@@ -22,6 +23,7 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, g
 		entries = append(entries,
 			bavard.Entry{File: filepath.Join(baseDir, "polynomial_test.go"), Templates: []string{"polynomial.test.go.tmpl"}},
 			bavard.Entry{File: filepath.Join(baseDir, "multilin_test.go"), Templates: []string{"multilin.test.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "multipoint_test.go"), Templates: []string{"multipoint.test.go.tmpl"}},
 		)
 	}
 
@@ -0,0 +1,22 @@
+package sumcheck
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/sumcheck/template"
+)
+
+func Generate(conf config.Curve, baseDir string, gen *common.Generator) error {
+	conf.Package = "sumcheck"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sumcheck.go"), Templates: []string{"sumcheck.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sumcheck_test.go"), Templates: []string{"sumcheck.test.go.tmpl"}},
+	}
+
+	sumcheckGen := common.NewDefaultGenerator(template.FS)
+	return sumcheckGen.Generate(conf, conf.Package, "", "", entries...)
+}